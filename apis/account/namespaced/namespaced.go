@@ -0,0 +1,2 @@
+// Package namespaced contains the namespaced variants of the account API group
+package namespaced
@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+// +kubebuilder:object:root=true
+
+// A Directory is the namespaced variant of the Directory managed resource
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type Directory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   v1alpha1.DirectorySpec   `json:"spec"`
+	Status v1alpha1.DirectoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectoryList contains a list of Directory
+type DirectoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Directory `json:"items"`
+}
+
+// ClusterScoped returns a copy of the Directory as cluster scoped Directory for the shared controller implementation.
+func (mg *Directory) ClusterScoped() resource.Managed {
+	return &v1alpha1.Directory{
+		TypeMeta:   metav1.TypeMeta{Kind: DirectoryKind, APIVersion: CRDGroupVersion.String()},
+		ObjectMeta: *mg.ObjectMeta.DeepCopy(),
+		Spec:       *mg.Spec.DeepCopy(),
+		Status:     *mg.Status.DeepCopy(),
+	}
+}
+
+// SetFromClusterScoped takes over metadata, spec and status of the cluster scoped copy.
+func (mg *Directory) SetFromClusterScoped(cs resource.Managed) {
+	if c, ok := cs.(*v1alpha1.Directory); ok {
+		mg.ObjectMeta = c.ObjectMeta
+		mg.Spec = c.Spec
+		mg.Status = c.Status
+	}
+}
+
+// Directory type metadata.
+var (
+	DirectoryKind             = reflect.TypeOf(Directory{}).Name()
+	DirectoryGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DirectoryKind}.String()
+	DirectoryKindAPIVersion   = DirectoryKind + "." + CRDGroupVersion.String()
+	DirectoryGroupVersionKind = CRDGroupVersion.WithKind(DirectoryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Directory{}, &DirectoryList{})
+}
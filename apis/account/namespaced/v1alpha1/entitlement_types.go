@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+// +kubebuilder:object:root=true
+
+// A Entitlement is the namespaced variant of the Entitlement managed resource
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VALIDATION",type="string",JSONPath=".status.conditions[?(@.type=='SoftValidation')].reason"
+// +kubebuilder:printcolumn:name="QUOTA",type="string",JSONPath=".status.conditions[?(@.type=='Quota')].reason",priority=1
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type Entitlement struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   v1alpha1.EntitlementSpec   `json:"spec"`
+	Status v1alpha1.EntitlementStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EntitlementList contains a list of Entitlement
+type EntitlementList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Entitlement `json:"items"`
+}
+
+// ClusterScoped returns a copy of the Entitlement as cluster scoped Entitlement for the shared controller implementation.
+func (mg *Entitlement) ClusterScoped() resource.Managed {
+	return &v1alpha1.Entitlement{
+		TypeMeta:   metav1.TypeMeta{Kind: EntitlementKind, APIVersion: CRDGroupVersion.String()},
+		ObjectMeta: *mg.ObjectMeta.DeepCopy(),
+		Spec:       *mg.Spec.DeepCopy(),
+		Status:     *mg.Status.DeepCopy(),
+	}
+}
+
+// SetFromClusterScoped takes over metadata, spec and status of the cluster scoped copy.
+func (mg *Entitlement) SetFromClusterScoped(cs resource.Managed) {
+	if c, ok := cs.(*v1alpha1.Entitlement); ok {
+		mg.ObjectMeta = c.ObjectMeta
+		mg.Spec = c.Spec
+		mg.Status = c.Status
+	}
+}
+
+// Entitlement type metadata.
+var (
+	EntitlementKind             = reflect.TypeOf(Entitlement{}).Name()
+	EntitlementGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: EntitlementKind}.String()
+	EntitlementKindAPIVersion   = EntitlementKind + "." + CRDGroupVersion.String()
+	EntitlementGroupVersionKind = CRDGroupVersion.WithKind(EntitlementKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Entitlement{}, &EntitlementList{})
+}
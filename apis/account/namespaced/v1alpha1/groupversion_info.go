@@ -0,0 +1,29 @@
+// Package v1alpha1 contains the namespaced variants of the account resources of the btp provider.
+// They share spec and status with their cluster scoped counterparts, but may only reference
+// NamespacedProviderConfigs, secrets and other resources of their own namespace.
+// +kubebuilder:object:generate=true
+// +groupName=account.m.btp.sap.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	CRDGroup   = "account.m.btp.sap.crossplane.io"
+	CRDVersion = "v1alpha1"
+)
+
+var (
+	// CRDGroupVersion is the API Group Version used to register the objects
+	CRDGroupVersion = schema.GroupVersion{Group: CRDGroup, Version: CRDVersion}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: CRDGroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
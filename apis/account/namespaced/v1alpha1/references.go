@@ -0,0 +1,119 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+// namespacedReader restricts reference resolution to the namespace of the referencing resource.
+type namespacedReader struct {
+	client.Reader
+	namespace string
+}
+
+func (r namespacedReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	key.Namespace = r.namespace
+	return r.Reader.Get(ctx, key, obj, opts...)
+}
+
+func (r namespacedReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return r.Reader.List(ctx, list, append(opts, client.InNamespace(r.namespace))...)
+}
+
+// DirectoryUuid extracts the guid of a namespaced Directory
+func DirectoryUuid() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Directory)
+		if !ok {
+			return ""
+		}
+		return v1alpha1.DirectoryUuid()(d.ClusterScoped())
+	}
+}
+
+// SubaccountUuid extracts the guid of a namespaced Subaccount
+func SubaccountUuid() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		sa, ok := mg.(*Subaccount)
+		if !ok {
+			return ""
+		}
+		return v1alpha1.SubaccountUuid()(sa.ClusterScoped())
+	}
+}
+
+// ResolveReferences of this Subaccount within its namespace.
+// The deprecated globalAccountRef is not resolved, the global account is taken from the NamespacedProviderConfig.
+func (mg *Subaccount) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(namespacedReader{Reader: c, namespace: mg.GetNamespace()}, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.DirectoryGuid,
+		Extract:      DirectoryUuid(),
+		Reference:    mg.Spec.ForProvider.DirectoryRef,
+		Selector:     mg.Spec.ForProvider.DirectorySelector,
+		To: reference.To{
+			List:    &DirectoryList{},
+			Managed: &Directory{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DirectoryGuid")
+	}
+	mg.Spec.ForProvider.DirectoryGuid = rsp.ResolvedValue
+	mg.Spec.ForProvider.DirectoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Directory within its namespace.
+func (mg *Directory) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(namespacedReader{Reader: c, namespace: mg.GetNamespace()}, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.DirectoryGuid,
+		Extract:      DirectoryUuid(),
+		Reference:    mg.Spec.ForProvider.DirectoryRef,
+		Selector:     mg.Spec.ForProvider.DirectorySelector,
+		To: reference.To{
+			List:    &DirectoryList{},
+			Managed: &Directory{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DirectoryGuid")
+	}
+	mg.Spec.ForProvider.DirectoryGuid = rsp.ResolvedValue
+	mg.Spec.ForProvider.DirectoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Entitlement within its namespace.
+func (mg *Entitlement) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(namespacedReader{Reader: c, namespace: mg.GetNamespace()}, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.SubaccountGuid,
+		Extract:      SubaccountUuid(),
+		Reference:    mg.Spec.ForProvider.SubaccountRef,
+		Selector:     mg.Spec.ForProvider.SubaccountSelector,
+		To: reference.To{
+			List:    &SubaccountList{},
+			Managed: &Subaccount{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.SubaccountGuid")
+	}
+	mg.Spec.ForProvider.SubaccountGuid = rsp.ResolvedValue
+	mg.Spec.ForProvider.SubaccountRef = rsp.ResolvedReference
+
+	return nil
+}
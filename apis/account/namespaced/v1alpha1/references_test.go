@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestEntitlementResolveReferencesWithinNamespace(t *testing.T) {
+	var requested client.ObjectKey
+	reader := &test.MockClient{MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		requested = key
+		sa := obj.(*Subaccount)
+		sa.Status.AtProvider.SubaccountGuid = &[]string{"subaccount-guid"}[0]
+		return nil
+	}}
+	cr := &Entitlement{ObjectMeta: metav1.ObjectMeta{Name: "entitlement", Namespace: "team-a"}}
+	cr.Spec.ForProvider.SubaccountRef = &xpv1.Reference{Name: "subaccount"}
+
+	err := cr.ResolveReferences(context.Background(), reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, client.ObjectKey{Namespace: "team-a", Name: "subaccount"}, requested)
+	assert.Equal(t, "subaccount-guid", cr.Spec.ForProvider.SubaccountGuid)
+}
+
+func TestNamespacedReaderList(t *testing.T) {
+	var listOpts []client.ListOption
+	reader := namespacedReader{Reader: &test.MockClient{MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+		listOpts = opts
+		return nil
+	}}, namespace: "team-a"}
+
+	assert.NoError(t, reader.List(context.Background(), &DirectoryList{}, client.MatchingLabels{"team": "a"}))
+	assert.Contains(t, listOpts, client.InNamespace("team-a"))
+}
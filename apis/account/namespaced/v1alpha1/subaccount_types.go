@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+// +kubebuilder:object:root=true
+
+// A Subaccount is the namespaced variant of the Subaccount managed resource
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="MOVE",type="string",JSONPath=".status.atProvider.move.phase",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type Subaccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   v1alpha1.SubaccountSpec   `json:"spec"`
+	Status v1alpha1.SubaccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubaccountList contains a list of Subaccount
+type SubaccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subaccount `json:"items"`
+}
+
+// ClusterScoped returns a copy of the Subaccount as cluster scoped Subaccount for the shared controller implementation.
+func (mg *Subaccount) ClusterScoped() resource.Managed {
+	return &v1alpha1.Subaccount{
+		TypeMeta:   metav1.TypeMeta{Kind: SubaccountKind, APIVersion: CRDGroupVersion.String()},
+		ObjectMeta: *mg.ObjectMeta.DeepCopy(),
+		Spec:       *mg.Spec.DeepCopy(),
+		Status:     *mg.Status.DeepCopy(),
+	}
+}
+
+// SetFromClusterScoped takes over metadata, spec and status of the cluster scoped copy.
+func (mg *Subaccount) SetFromClusterScoped(cs resource.Managed) {
+	if c, ok := cs.(*v1alpha1.Subaccount); ok {
+		mg.ObjectMeta = c.ObjectMeta
+		mg.Spec = c.Spec
+		mg.Status = c.Status
+	}
+}
+
+// Subaccount type metadata.
+var (
+	SubaccountKind             = reflect.TypeOf(Subaccount{}).Name()
+	SubaccountGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SubaccountKind}.String()
+	SubaccountKindAPIVersion   = SubaccountKind + "." + CRDGroupVersion.String()
+	SubaccountGroupVersionKind = CRDGroupVersion.WithKind(SubaccountKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Subaccount{}, &SubaccountList{})
+}
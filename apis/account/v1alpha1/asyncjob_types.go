@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Status values of asynchronous jobs as reported by the job management APIs of BTP
+const (
+	JobStatusInProgress = "IN_PROGRESS"
+	JobStatusCompleted  = "COMPLETED"
+	JobStatusFailed     = "FAILED"
+)
+
+// AsyncJob is a long-running operation processed asynchronously by BTP, its status is polled from the job management API.
+type AsyncJob struct {
+	// ID of the job as returned by the operation that started it
+	ID string `json:"id"`
+
+	// Operation that started the job, e.g. Create or Delete
+	Operation string `json:"operation"`
+
+	// Status of the job, one of IN_PROGRESS, COMPLETED or FAILED
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Description of the job status, contains the failure details once the job failed
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// StartTime is the time the job has been recorded
+	StartTime metav1.Time `json:"startTime"`
+
+	// LastPollTime is the time the status of the job has been polled last
+	// +optional
+	LastPollTime *metav1.Time `json:"lastPollTime,omitempty"`
+
+	// Polls counts the status requests of the job, the interval between them grows with every poll
+	// +optional
+	Polls int `json:"polls,omitempty"`
+}
+
+const AsyncJobCondition xpv1.ConditionType = "AsyncJob"
+const JobInProgressReason xpv1.ConditionReason = "JobInProgress"
+const JobCompletedReason xpv1.ConditionReason = "JobCompleted"
+const JobFailedReason xpv1.ConditionReason = "JobFailed"
+
+// JobCondition reports the progress of the job, the condition is True once it completed successfully and False if it failed.
+func JobCondition(job *AsyncJob) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               AsyncJobCondition,
+		LastTransitionTime: metav1.Now(),
+	}
+	switch job.Status {
+	case JobStatusCompleted:
+		c.Status = corev1.ConditionTrue
+		c.Reason = JobCompletedReason
+		c.Message = fmt.Sprintf("%s job %s completed", job.Operation, job.ID)
+	case JobStatusFailed:
+		c.Status = corev1.ConditionFalse
+		c.Reason = JobFailedReason
+		c.Message = fmt.Sprintf("%s job %s failed: %s", job.Operation, job.ID, job.Description)
+	default:
+		c.Status = corev1.ConditionUnknown
+		c.Reason = JobInProgressReason
+		c.Message = fmt.Sprintf("%s job %s in progress since %s", job.Operation, job.ID, job.StartTime.UTC().Format(time.RFC3339))
+		if job.Description != "" {
+			c.Message += ": " + job.Description
+		}
+	}
+	return c
+}
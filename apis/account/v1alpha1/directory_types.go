@@ -3,6 +3,8 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -12,6 +14,11 @@ import (
 var DirectoryEntityStateOk = "OK"
 
 // DirectoryParameters are the configurable fields of a Directory.
+// +kubebuilder:validation:XValidation:rule="!has(self.customProperties) || !has(self.labels) || self.customProperties.all(k, !(k in self.labels))",message="customProperties must not use keys of labels"
+// +kubebuilder:validation:XValidation:rule="!has(self.entitlements) || (has(self.directoryFeatures) && 'ENTITLEMENTS' in self.directoryFeatures)",message="entitlements require the ENTITLEMENTS feature"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.directoryGuid) || (has(self.directoryGuid) && self.directoryGuid == oldSelf.directoryGuid)",message="directoryGuid can't be changed once set, directories can't be moved to another parent"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.directoryGuid) || !has(self.directoryGuid) || has(self.directoryRef) || has(self.directorySelector)",message="directoryGuid can't be added after creation, directories can't be moved to another parent"
+// +kubebuilder:validation:XValidation:rule="has(self.directoryRef) == has(oldSelf.directoryRef)",message="directoryRef can't be added or removed after creation, directories can't be moved to another parent"
 type DirectoryParameters struct {
 
 	// Description of the Directory
@@ -19,9 +26,19 @@ type DirectoryParameters struct {
 	Description *string `json:"description,omitempty"`
 
 	// Additional admins of the directory. Applies only to directories that have the user authorization management feature enabled. Do not add yourself as you are assigned as a directory admin by default. Example: ["admin1@example.com", "admin2@example.com"]
+	// Admins are assigned again whenever the list changes while AUTHORIZATIONS is enabled. The accounts service only assigns admins,
+	// with directoryAdminsApiCredentials set they are compared with the users of the "Directory Administrator" role collection
+	// and admins removed from the list are revoked. Admins never listed keep their assignment, the user of the ProviderConfig
+	// credentials is never revoked.
 	// +kubebuilder:validation:MinItems=2
 	DirectoryAdmins []string `json:"directoryAdmins"`
 
+	// DirectoryAdminsApiCredentials are credentials of the xsuaa api of the directory, used to reconcile the directory admins
+	// against the users of the "Directory Administrator" role collection. Without them the admins can only be compared with
+	// the ones last assigned by crossplane, as the accounts service does not return the admins of a directory.
+	// +kubebuilder:validation:Optional
+	DirectoryAdminsApiCredentials *DirectoryAdminsApiCredentials `json:"directoryAdminsApiCredentials,omitempty"`
+
 	// <b>The features to be enabled in the directory. The available features are:</b>
 	// -	<b>DEFAULT</b>: (Mandatory) All directories provide the following basic features: (1) Group and filter subaccounts for reports and filters, (2) monitor usage and costs on a directory level (costs only available for contracts that use the consumption-based commercial model), and (3) set custom properties and tags to the directory for identification and reporting purposes.
 	// -	<b>ENTITLEMENTS</b>: (Optional) Enables the assignment of a quota for services and applications to the directory from the global account quota for distribution to the subaccounts under this directory.
@@ -34,7 +51,12 @@ type DirectoryParameters struct {
 	// [DEFAULT,ENTITLEMENTS]
 	// [DEFAULT,ENTITLEMENTS,AUTHORIZATIONS]<br/>
 	// Unique: true
+	// Features can be added after creation, ENTITLEMENTS can only be removed together with AUTHORIZATIONS.
 	// +optional
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:rule="self.all(f, f in ['DEFAULT', 'ENTITLEMENTS', 'AUTHORIZATIONS'])",message="directoryFeatures must only contain DEFAULT, ENTITLEMENTS or AUTHORIZATIONS"
+	// +kubebuilder:validation:XValidation:rule="size(self) == 0 || 'DEFAULT' in self",message="directoryFeatures must contain DEFAULT, it can't be removed"
+	// +kubebuilder:validation:XValidation:rule="!('AUTHORIZATIONS' in self) || 'ENTITLEMENTS' in self",message="AUTHORIZATIONS requires ENTITLEMENTS"
 	DirectoryFeatures []string `json:"directoryFeatures"`
 
 	// The display name of the directory.
@@ -55,14 +77,38 @@ type DirectoryParameters struct {
 	// +optional
 	Labels map[string][]string `json:"labels,omitempty"`
 
+	// CustomProperties are user-defined properties with a single value, e.g. a cost center or an owner.
+	// The accounts service stores them as labels, so their keys must not be used in labels.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
+	// EntitySettings of the directory by key, each value is a json object.
+	// Once set, settings not listed here are deleted; leave it unset to not manage entity settings at all.
+	// +optional
+	EntitySettings map[string]runtime.RawExtension `json:"entitySettings,omitempty"`
+
+	// Entitlements assigned to the directory from the global account quota, requires the ENTITLEMENTS feature.
+	// Plans that are not listed are not changed, remove a plan by setting its amount to 0 or enable to false.
+	// +optional
+	// +listType=map
+	// +listMapKey=service
+	// +listMapKey=plan
+	// +listMapKey=planUniqueIdentifier
+	Entitlements []DirectoryEntitlementAssignment `json:"entitlements,omitempty"`
+
 	// Subdomain Applies only to directories that have the user authorization management feature enabled.  The subdomain becomes part of the path used to access the authorization tenant of the directory. Must be unique within the defined region. Use only letters (a-z), digits (0-9), and hyphens (not at start or end). Maximum length is 63 characters. Cannot be changed after the directory has been created.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="subdomain can't be updated once set"
 	Subdomain *string `json:"subdomain,omitempty"`
 
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Directory
 	// +crossplane:generate:reference:refFieldName=DirectoryRef
 	// +crossplane:generate:reference:selectorFieldName=DirectorySelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.DirectoryUuid()
+	// DirectoryGuid of the parent directory, the directory is created in the global account if not set.
+	// Directories can be nested up to 5 levels. The parent is immutable, since the accounts service has no API to move
+	// directories; recreate the directory in the new parent instead.
 	DirectoryGuid string `json:"directoryGuid,omitempty"`
 
 	// +kubebuilder:validation:Optional
@@ -71,6 +117,60 @@ type DirectoryParameters struct {
 	DirectoryRef *xpv1.Reference `json:"directoryRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"Directory" reference-apiversion:"v1alpha1"`
 }
 
+// DirectoryEntitlementAssignment is the assignment of a service plan to a directory
+// +kubebuilder:validation:XValidation:rule="has(self.amount) != has(self.enable)",message="exactly one of amount and enable must be set"
+type DirectoryEntitlementAssignment struct {
+	// The name of the service.
+	Service string `json:"service"`
+	// The name of the service plan.
+	Plan string `json:"plan"`
+	// The unique identifier of the plan, to distinguish between plans of the same name in different data centers.
+	// If empty, all plans of the name are assigned, except for those listed with their identifier.
+	// +optional
+	// +kubebuilder:default=""
+	PlanUniqueIdentifier string `json:"planUniqueIdentifier"`
+	// The quota of the plan assigned to the directory. Relevant only for plans with a numeric quota.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Amount *int `json:"amount,omitempty"`
+	// Whether the plan is assigned to the directory. Relevant only for plans without a numeric quota.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+	// Whether to assign the plan to all subaccounts currently located in the directory, using autoDistributeAmount for plans with a numeric quota.
+	// This is applied whenever the assignment of the directory is changed, it can't be observed.
+	// +optional
+	Distribute bool `json:"distribute,omitempty"`
+	// Whether to assign the plan to subaccounts created in or moved to the directory in the future.
+	// +optional
+	AutoAssign bool `json:"autoAssign,omitempty"`
+	// The quota assigned to each subaccount by distribute and autoAssign. Relevant only for plans with a numeric quota.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	AutoDistributeAmount *int `json:"autoDistributeAmount,omitempty"`
+}
+
+// DirectoryEntitlementAssignmentObservation is the assignment of a service plan to a directory as observed
+type DirectoryEntitlementAssignmentObservation struct {
+	// The name of the service.
+	Service string `json:"service"`
+	// The name of the service plan.
+	Plan string `json:"plan"`
+	// The unique identifier of the plan.
+	// +optional
+	PlanUniqueIdentifier string `json:"planUniqueIdentifier,omitempty"`
+	// The assignment of the plan to the directory.
+	Assigned Assignable `json:"assigned"`
+}
+
+// DirectoryAdminsApiCredentials reference the credentials of the xsuaa api of a directory.
+type DirectoryAdminsApiCredentials struct {
+	// Source of the credentials.
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	Source xpv1.CredentialsSource `json:"source"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
 // DirectoryObservation are the observable fields of a Directory.
 type DirectoryObservation struct {
 	// The GUID of the directory
@@ -82,8 +182,29 @@ type DirectoryObservation struct {
 	StateMessage *string `json:"stateMessage,omitempty"`
 	// Subdomain currently present in external system
 	Subdomain *string `json:"subdomain,omitempty"`
+	// ParentGuid of the directory the directory is located in, not set if it is located in the global account
+	// +optional
+	ParentGuid *string `json:"parentGuid,omitempty"`
 	// Features currently present in external system
 	DirectoryFeatures []string `json:"directoryFeatures"`
+	// DirectoryAdmins are the users of the "Directory Administrator" role collection, only observed with directoryAdminsApiCredentials
+	// +optional
+	DirectoryAdmins *[]string `json:"directoryAdmins,omitempty"`
+	// ManagedDirectoryAdmins are the admins last assigned by crossplane, the accounts service does not return the admins of a directory
+	// +optional
+	ManagedDirectoryAdmins []string `json:"managedDirectoryAdmins,omitempty"`
+	// CustomProperties currently present in external system
+	// +optional
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+	// EntitySettings currently present in external system, only observed if entitySettings are managed
+	// +optional
+	EntitySettings map[string]runtime.RawExtension `json:"entitySettings,omitempty"`
+	// Entitlements currently assigned to the directory, only observed for plans listed in entitlements
+	// +optional
+	Entitlements []DirectoryEntitlementAssignmentObservation `json:"entitlements,omitempty"`
+	// Job is the last asynchronous operation started on the directory
+	// +optional
+	Job *AsyncJob `json:"job,omitempty"`
 }
 
 // A DirectorySpec defines the desired state of a Directory.
@@ -135,3 +256,29 @@ var (
 func init() {
 	SchemeBuilder.Register(&Directory{}, &DirectoryList{})
 }
+
+const HierarchyCondition xpv1.ConditionType = "Hierarchy"
+const HierarchyInSyncReason xpv1.ConditionReason = "InSync"
+const MoveNotSupportedReason xpv1.ConditionReason = "MoveNotSupported"
+
+// HierarchyInSync indicates that the directory is located in its configured parent.
+func HierarchyInSync() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               HierarchyCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             HierarchyInSyncReason,
+	}
+}
+
+// ParentChanged indicates that the actual parent differs from the configured one, e.g. after the directory has been
+// moved outside of crossplane. Directories can't be moved by crossplane, their parent is immutable.
+func ParentChanged(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               HierarchyCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             MoveNotSupportedReason,
+		Message:            msg,
+	}
+}
@@ -50,6 +50,27 @@ type EntitlementObservation struct {
 	Assigned *Assignable `json:"assigned,omitempty"`
 	// Entitled is the overall available quota for the global account / directory which is available to assign
 	Entitled Entitled `json:"entitled,omitempty"`
+	// Quota reports how the quota of the service plan is used by all managed entitlements of the plan. Only set for plans with a numeric quota.
+	Quota *QuotaUsage `json:"quota,omitempty"`
+}
+
+// QuotaUsage is the quota accounting of a service plan across all managed entitlements of that plan
+type QuotaUsage struct {
+	// The GUID of the global account or directory the subaccount draws its quota from, only subaccounts drawing from the same pool are accounted.
+	// +optional
+	Pool string `json:"pool,omitempty"`
+	// The quota of the plan entitled to the global account or directory the subaccount draws its quota from.
+	Entitled int `json:"entitled"`
+	// The quota of the plan that is not assigned to any subaccount yet.
+	Remaining int `json:"remaining"`
+	// The maximum quota of the plan that can be assigned to a single subaccount, 0 if not limited.
+	MaxPerSubaccount int `json:"maxPerSubaccount,omitempty"`
+	// The quota this subaccount requires on top of its current assignment.
+	Requested int `json:"requested"`
+	// The quota all subaccounts with managed entitlements of the plan drawing from the same pool require on top of their current assignments.
+	Pending int `json:"pending"`
+	// Number of subaccounts with managed entitlements of the plan drawing from the same pool.
+	Subaccounts int `json:"subaccounts"`
 }
 
 type Assignable struct {
@@ -222,6 +243,7 @@ type EntitlementStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="VALIDATION",type="string",JSONPath=".status.conditions[?(@.type=='SoftValidation')].reason"
+// +kubebuilder:printcolumn:name="QUOTA",type="string",JSONPath=".status.conditions[?(@.type=='Quota')].reason",priority=1
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
@@ -285,3 +307,44 @@ func ValidationCondition(validationIssues []string) xpv1.Condition {
 
 	return ValidationError(strings.Join(validationIssues, "\n"))
 }
+
+// QuotaCondition reports whether the required amount fits into the quota of the service plan.
+const QuotaCondition xpv1.ConditionType = "Quota"
+
+const (
+	// QuotaAvailableReason is used if the required amount fits into the remaining quota.
+	QuotaAvailableReason xpv1.ConditionReason = "Available"
+	// QuotaOvercommittedReason is used if the required amount of this subaccount fits, but not the one of all managed subaccounts of the plan.
+	QuotaOvercommittedReason xpv1.ConditionReason = "Overcommitted"
+	// QuotaExceededReason is used if the required amount of this subaccount can't be assigned, it is not sent to the API.
+	QuotaExceededReason xpv1.ConditionReason = "Exceeded"
+)
+
+func QuotaAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               QuotaCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             QuotaAvailableReason,
+	}
+}
+
+func QuotaOvercommitted(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               QuotaCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             QuotaOvercommittedReason,
+		Message:            msg,
+	}
+}
+
+func QuotaExceeded(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               QuotaCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             QuotaExceededReason,
+		Message:            msg,
+	}
+}
@@ -0,0 +1,194 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EntitlementCatalogParameters are the configurable fields of an EntitlementCatalog.
+type EntitlementCatalogParameters struct {
+	// ServiceNames restricts the catalog to these services, all entitled services are listed if unset
+	// +optional
+	ServiceNames []string `json:"serviceNames,omitempty"`
+
+	// SyncInterval is the minimum time between two syncs of the catalog
+	// +optional
+	// +kubebuilder:default="10m"
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty"`
+}
+
+// EntitlementCatalogObservation are the observable fields of an EntitlementCatalog.
+type EntitlementCatalogObservation struct {
+	// Services entitled to the global account, sorted by name
+	// +optional
+	Services []CatalogService `json:"services,omitempty"`
+
+	// Regions available to the global account, sorted by name
+	// +optional
+	Regions []CatalogRegion `json:"regions,omitempty"`
+
+	// LastSyncTime is when the catalog has been synced last
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// ObservedGeneration is the generation of the spec the catalog has been synced for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// CatalogService is a service entitled to the global account.
+type CatalogService struct {
+	// Name of the service, used as serviceName of an Entitlement
+	Name string `json:"name"`
+
+	// DisplayName of the service for customer-facing UIs
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// BusinessCategory of the service, e.g. AI or APPLICATION_DEVELOPMENT_AND_AUTOMATION
+	// +optional
+	BusinessCategory string `json:"businessCategory,omitempty"`
+
+	// Plans of the service entitled to the global account, sorted by name
+	// +optional
+	Plans []CatalogPlan `json:"plans,omitempty"`
+}
+
+// CatalogPlan is a service plan entitled to the global account.
+type CatalogPlan struct {
+	// Name of the plan, used as servicePlanName of an Entitlement
+	Name string `json:"name"`
+
+	// DisplayName of the plan for customer-facing UIs
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// UniqueIdentifier of the plan, used as servicePlanUniqueIdentifier of an Entitlement if plans of the same name exist
+	// +optional
+	UniqueIdentifier string `json:"uniqueIdentifier,omitempty"`
+
+	// Category of the plan, e.g. SERVICE, ELASTIC_SERVICE, APPLICATION or ENVIRONMENT.
+	// Plans with numeric quota need an amount, all others are enabled.
+	// +optional
+	Category string `json:"category,omitempty"`
+
+	// Beta is true for beta plans, which can only be assigned to subaccounts with betaEnabled
+	// +optional
+	Beta bool `json:"beta,omitempty"`
+
+	// Unlimited is true for plans without quota limit
+	// +optional
+	Unlimited bool `json:"unlimited,omitempty"`
+
+	// Amount entitled to the global account
+	// +optional
+	Amount int `json:"amount,omitempty"`
+
+	// RemainingAmount of the global account's quota that is not yet assigned
+	// +optional
+	RemainingAmount int `json:"remainingAmount,omitempty"`
+
+	// MaxAllowedSubaccountQuota limits the amount assignable to a single subaccount, unlimited if 0
+	// +optional
+	MaxAllowedSubaccountQuota int `json:"maxAllowedSubaccountQuota,omitempty"`
+
+	// Regions the plan is available in, all regions of the global account if empty
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+}
+
+// CatalogRegion is a region the global account may create subaccounts in.
+type CatalogRegion struct {
+	// Name of the data center, e.g. cf-eu10
+	Name string `json:"name"`
+
+	// Region of the data center, used as region of a Subaccount, e.g. eu10
+	Region string `json:"region"`
+
+	// DisplayName of the data center for customer-facing UIs
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Environment of the data center, e.g. cloudfoundry or kyma
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// IaasProvider of the data center, e.g. AWS, AZURE or GCP
+	// +optional
+	IaasProvider string `json:"iaasProvider,omitempty"`
+
+	// SupportsTrial is true if trial subaccounts can be created in the data center
+	// +optional
+	SupportsTrial bool `json:"supportsTrial,omitempty"`
+}
+
+// A EntitlementCatalogSpec defines the desired state of an EntitlementCatalog.
+type EntitlementCatalogSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EntitlementCatalogParameters `json:"forProvider,omitempty"`
+}
+
+// A EntitlementCatalogStatus represents the observed state of an EntitlementCatalog.
+type EntitlementCatalogStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EntitlementCatalogObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EntitlementCatalog lists the services, plans and regions entitled to the global account of its ProviderConfig.
+// It is read-only, nothing is created or deleted in BTP.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LAST-SYNC",type="date",JSONPath=".status.atProvider.lastSyncTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,sap}
+type EntitlementCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EntitlementCatalogSpec   `json:"spec"`
+	Status EntitlementCatalogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EntitlementCatalogList contains a list of EntitlementCatalog
+type EntitlementCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EntitlementCatalog `json:"items"`
+}
+
+// EntitlementCatalog type metadata.
+var (
+	EntitlementCatalogKind             = reflect.TypeOf(EntitlementCatalog{}).Name()
+	EntitlementCatalogGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: EntitlementCatalogKind}.String()
+	EntitlementCatalogKindAPIVersion   = EntitlementCatalogKind + "." + CRDGroupVersion.String()
+	EntitlementCatalogGroupVersionKind = CRDGroupVersion.WithKind(EntitlementCatalogKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EntitlementCatalog{}, &EntitlementCatalogList{})
+}
+
+// FindPlan returns the cataloged plan of the service, the unique identifier is only compared if given
+func (o *EntitlementCatalogObservation) FindPlan(service, plan string, uniqueIdentifier *string) *CatalogPlan {
+	for i := range o.Services {
+		if o.Services[i].Name != service {
+			continue
+		}
+		for j := range o.Services[i].Plans {
+			p := &o.Services[i].Plans[j]
+			if p.Name == plan && (uniqueIdentifier == nil || *uniqueIdentifier == p.UniqueIdentifier) {
+				return p
+			}
+		}
+	}
+	return nil
+}
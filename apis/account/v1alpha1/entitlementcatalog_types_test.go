@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindPlan(t *testing.T) {
+	catalog := EntitlementCatalogObservation{
+		Services: []CatalogService{
+			{
+				Name: "auditlog-viewer",
+				Plans: []CatalogPlan{
+					{Name: "free", UniqueIdentifier: "auditlog-viewer-free"},
+					{Name: "free", UniqueIdentifier: "auditlog-viewer-free-eu10"},
+				},
+			},
+		},
+	}
+	eu10 := "auditlog-viewer-free-eu10"
+	other := "auditlog-viewer-free-us10"
+
+	tests := map[string]struct {
+		reason           string
+		service          string
+		plan             string
+		uniqueIdentifier *string
+		want             *CatalogPlan
+	}{
+		"ByName": {
+			reason:  "The first plan of the name is found if no unique identifier is given",
+			service: "auditlog-viewer",
+			plan:    "free",
+			want:    &catalog.Services[0].Plans[0],
+		},
+		"ByUniqueIdentifier": {
+			reason:           "The plan with the given unique identifier is found",
+			service:          "auditlog-viewer",
+			plan:             "free",
+			uniqueIdentifier: &eu10,
+			want:             &catalog.Services[0].Plans[1],
+		},
+		"UnknownUniqueIdentifier": {
+			reason:           "A plan with another unique identifier is not found",
+			service:          "auditlog-viewer",
+			plan:             "free",
+			uniqueIdentifier: &other,
+		},
+		"UnknownService": {
+			reason:  "Plans of services not in the catalog are not found",
+			service: "postgresql-db",
+			plan:    "free",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := catalog.FindPlan(tc.service, tc.plan, tc.uniqueIdentifier)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nFindPlan(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
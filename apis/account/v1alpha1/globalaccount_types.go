@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -10,7 +11,20 @@ import (
 )
 
 // GlobalAccountParameters are the configurable fields of a GlobalAccount.
+// Only the fields set are managed, unset fields keep the values of the global account.
 type GlobalAccountParameters struct {
+	// DisplayName of the global account
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the global account
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels of the global account, each label has a key and up to 10 values. Labels are not managed if unset.
+	// +optional
+	Labels map[string][]string `json:"labels,omitempty"`
 }
 
 // GlobalAccountObservation are the observable fields of a GlobalAccount.
@@ -18,6 +32,81 @@ type GlobalAccountObservation struct {
 	// BTP Global Account GUID
 	// +optional
 	Guid string `json:"guid,omitempty"`
+
+	// DisplayName of the global account
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of the global account
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Labels of the global account
+	// +optional
+	Labels map[string][]string `json:"labels,omitempty"`
+
+	// Subdomain of the global account
+	// +optional
+	Subdomain *string `json:"subdomain,omitempty"`
+
+	// EntityState is the processing state of the global account
+	// +optional
+	EntityState *string `json:"entityState,omitempty"`
+
+	// StateMessage details the processing state
+	// +optional
+	StateMessage *string `json:"stateMessage,omitempty"`
+
+	// Contract of the global account
+	// +optional
+	Contract GlobalAccountContract `json:"contract,omitempty"`
+
+	// Regions available to the global account, sorted by name
+	// +optional
+	Regions []CatalogRegion `json:"regions,omitempty"`
+}
+
+// GlobalAccountContract describes the contract and availability of a global account.
+type GlobalAccountContract struct {
+	// CommercialModel of the global account, e.g. Subscription or ConsumptionBased
+	// +optional
+	CommercialModel string `json:"commercialModel,omitempty"`
+
+	// ConsumptionBased is true for contracts of the consumption-based commercial model
+	// +optional
+	ConsumptionBased bool `json:"consumptionBased,omitempty"`
+
+	// LicenseType of the global account, e.g. DEVELOPER, CUSTOMER, PARTNER, INTERNAL, TRIAL
+	// +optional
+	LicenseType string `json:"licenseType,omitempty"`
+
+	// UseFor tells whether the global account is used for production or testing
+	// +optional
+	UseFor *string `json:"useFor,omitempty"`
+
+	// ContractStatus of the global account, e.g. ACTIVE, PENDING_TERMINATION or SUSPENDED
+	// +optional
+	ContractStatus *string `json:"contractStatus,omitempty"`
+
+	// TerminationNotificationStatus tells whether the termination of the contract has been notified
+	// +optional
+	TerminationNotificationStatus *string `json:"terminationNotificationStatus,omitempty"`
+
+	// GeoAccess restricts the regions available to the global account, e.g. STANDARD or EU_ACCESS
+	// +optional
+	GeoAccess string `json:"geoAccess,omitempty"`
+
+	// BackwardCompliantEU is true for EU access global accounts that may also use regions outside of the EU
+	// +optional
+	BackwardCompliantEU *bool `json:"backwardCompliantEU,omitempty"`
+
+	// ExpiryDate of trial global accounts and of contracts with an end
+	// +optional
+	ExpiryDate *metav1.Time `json:"expiryDate,omitempty"`
+
+	// RenewalDate of the contract
+	// +optional
+	RenewalDate *metav1.Time `json:"renewalDate,omitempty"`
 }
 
 // A GlobalAccountSpec defines the desired state of a GlobalAccount.
@@ -38,6 +127,8 @@ type GlobalAccountStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LICENSE",type="string",JSONPath=".status.atProvider.contract.licenseType",priority=1
+// +kubebuilder:printcolumn:name="EXPIRY",type="date",JSONPath=".status.atProvider.contract.expiryDate",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,sap}
@@ -70,3 +161,29 @@ var (
 func init() {
 	SchemeBuilder.Register(&GlobalAccount{}, &GlobalAccountList{})
 }
+
+const ExpiryCondition xpv1.ConditionType = "Expiry"
+const NoExpiryReason xpv1.ConditionReason = "NoExpiry"
+const ExpiresSoonReason xpv1.ConditionReason = "ExpiresSoon"
+const ExpiredReason xpv1.ConditionReason = "Expired"
+
+// NotExpiring indicates that the global account has no expiry date within the warning period.
+func NotExpiring() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               ExpiryCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             NoExpiryReason,
+	}
+}
+
+// Expiring indicates that the global account expires soon or has expired.
+func Expiring(reason xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               ExpiryCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            msg,
+	}
+}
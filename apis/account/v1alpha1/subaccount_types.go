@@ -3,13 +3,16 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
 // SubaccountParameters are the configurable fields of a Subaccount.
+// +kubebuilder:validation:XValidation:rule="!has(self.customProperties) || !has(self.labels) || self.customProperties.all(k, !(k in self.labels))",message="customProperties must not use keys of labels"
 type SubaccountParameters struct {
 	// enable beta services and applications?
 	// +optional
@@ -22,28 +25,67 @@ type SubaccountParameters struct {
 	Description string `json:"description,omitempty"`
 
 	// Display name
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinLength=1
-	DisplayName string `json:"displayName"`
+	DisplayName string `json:"displayName,omitempty"`
 
 	// Labels, up to 10 user-defined labels to assign as key-value pairs to the subaccount. Each label has a name (key) that you specify, and to which you can assign up to 10 corresponding values or leave empty.
 	// Keys and values are each limited to 63 characters.
 	// +optional
 	Labels map[string][]string `json:"labels,omitempty"`
 
+	// CustomProperties are user-defined properties with a single value, e.g. a cost center or an owner.
+	// The accounts service stores them as labels, so their keys must not be used in labels.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
+	// EntitySettings of the subaccount by key, each value is a json object.
+	// Once set, settings not listed here are deleted; leave it unset to not manage entity settings at all.
+	// +optional
+	EntitySettings map[string]runtime.RawExtension `json:"entitySettings,omitempty"`
+
 	// Region
 	// Change requires recreation
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinLength=1
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Admins for the subaccount (service account user already included)
+	// With subaccountAdminsApiCredentials set, the admins are reconciled against the users of the default identity provider
+	// assigned to the "Subaccount Administrator" role collection, otherwise they are only assigned on creation.
+	// Imported subaccounts only get their admins back-filled from that role collection with subaccountAdminsApiCredentials set,
+	// as the accounts API does not return the admins.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinItems=1
+	SubaccountAdmins []string `json:"subaccountAdmins,omitempty"`
 
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="subaccountAdmins can't be updated once set"
-	SubaccountAdmins []string `json:"subaccountAdmins"`
+	// SubaccountAdminsPolicy defines how admins assigned outside of crossplane are treated.
+	// KeepUnmanaged only revokes admins that have been removed from subaccountAdmins, Exclusive revokes all admins not listed in subaccountAdmins.
+	// The user of the ProviderConfig credentials is never revoked.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Exclusive;KeepUnmanaged
+	// +kubebuilder:default:=KeepUnmanaged
+	SubaccountAdminsPolicy SubaccountAdminsPolicy `json:"subaccountAdminsPolicy,omitempty"`
+
+	// SubaccountAdminsApiCredentials are credentials of the xsuaa api of the subaccount (xsuaa service plan apiaccess), used to reconcile the subaccount admins.
+	// +kubebuilder:validation:Optional
+	SubaccountAdminsApiCredentials *SubaccountAdminsApiCredentials `json:"subaccountAdminsApiCredentials,omitempty"`
 
 	// Subdomain
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinLength=1
-	Subdomain string `json:"subdomain"`
+	Subdomain string `json:"subdomain,omitempty"`
+
+	// DeletionProtection refuses the deletion of the subaccount, it needs to be disabled before the resource can be deleted.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
+	// PreDeleteCheck blocks the deletion of the subaccount as long as environments, subscriptions or service instances
+	// that are not managed by crossplane remain in it, since they would be deleted along with the subaccount.
+	// Without it the deletion is blocked, set its policy to Force to delete the subaccount regardless of remaining resources.
+	// +optional
+	PreDeleteCheck *SubaccountPreDeleteCheck `json:"preDeleteCheck,omitempty"`
 
 	// Used for production
 	// +kubebuilder:validation:MinLength=1
@@ -77,6 +119,58 @@ type SubaccountParameters struct {
 	DirectoryRef *xpv1.Reference `json:"directoryRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"Directory" reference-apiversion:"v1alpha1"`
 }
 
+// SubaccountAdminsPolicy defines how admins assigned outside of crossplane are treated.
+type SubaccountAdminsPolicy string
+
+const (
+	// SubaccountAdminsExclusive revokes all admins, that are not listed in the spec, except the user of the provider.
+	SubaccountAdminsExclusive SubaccountAdminsPolicy = "Exclusive"
+	// SubaccountAdminsKeepUnmanaged keeps admins, that have never been listed in the spec.
+	SubaccountAdminsKeepUnmanaged SubaccountAdminsPolicy = "KeepUnmanaged"
+)
+
+// SubaccountAdminsApiCredentials reference the credentials of the xsuaa api of the subaccount,
+// a service key of the xsuaa service plan apiaccess in json format.
+type SubaccountAdminsApiCredentials struct {
+	// Source of the credentials.
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	Source xpv1.CredentialsSource `json:"source"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
+// SubaccountPreDeleteCheckPolicy defines whether resources remaining in a subaccount block its deletion.
+type SubaccountPreDeleteCheckPolicy string
+
+const (
+	// PreDeleteCheckBlock blocks the deletion as long as resources not managed by crossplane remain in the subaccount.
+	PreDeleteCheckBlock SubaccountPreDeleteCheckPolicy = "Block"
+	// PreDeleteCheckForce deletes the subaccount along with all remaining resources.
+	PreDeleteCheckForce SubaccountPreDeleteCheckPolicy = "Force"
+)
+
+// SubaccountPreDeleteCheck configures the check for remaining resources before a subaccount is deleted.
+// Resources are considered managed by crossplane, if their ID or name matches the external-name of a resource referencing the subaccount.
+// The check is repeated on every deletion attempt, so the referenced secrets must exist until the subaccount is deleted.
+// +kubebuilder:validation:XValidation:rule="(has(self.policy) && self.policy == 'Force') || has(self.cloudManagementSecretRef)",message="cloudManagementSecretRef is required unless policy is Force"
+type SubaccountPreDeleteCheck struct {
+	// Policy Block refuses the deletion while resources remain, Force deletes the subaccount regardless.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Block;Force
+	// +kubebuilder:default:=Block
+	Policy SubaccountPreDeleteCheckPolicy `json:"policy,omitempty"`
+
+	// CloudManagementSecretRef references the connection secret of a CloudManagement of the subaccount,
+	// used to list its environments and subscriptions.
+	// +kubebuilder:validation:Optional
+	CloudManagementSecretRef *xpv1.SecretReference `json:"cloudManagementSecretRef,omitempty"`
+
+	// ServiceManagerSecretRef references the connection secret of a ServiceManager of the subaccount,
+	// service instances are only checked if it is set.
+	// +kubebuilder:validation:Optional
+	ServiceManagerSecretRef *xpv1.SecretReference `json:"serviceManagerSecretRef,omitempty"`
+}
+
 // SubaccountObservation are the observable fields of a Subaccount.
 type SubaccountObservation struct {
 	// Subaccount ID
@@ -106,6 +200,14 @@ type SubaccountObservation struct {
 	// +optional
 	Labels *map[string][]string `json:"labels,omitempty"`
 
+	// CustomProperties currently present in external system
+	// +optional
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
+	// EntitySettings currently present in external system, only observed if entitySettings are managed
+	// +optional
+	EntitySettings map[string]runtime.RawExtension `json:"entitySettings,omitempty"`
+
 	// Region
 	// Change requires recreation
 	Region *string `json:"region,omitempty"`
@@ -113,6 +215,10 @@ type SubaccountObservation struct {
 	// Admins for the subaccount (service account user already included)
 	SubaccountAdmins *[]string `json:"subaccountAdmins,omitempty"`
 
+	// ManagedSubaccountAdmins are the admins that have been assigned by crossplane
+	// +optional
+	ManagedSubaccountAdmins []string `json:"managedSubaccountAdmins,omitempty"`
+
 	// Subdomain
 	Subdomain *string `json:"subdomain,omitempty"`
 
@@ -124,9 +230,52 @@ type SubaccountObservation struct {
 
 	// The unique ID of the subaccount's global account.
 	GlobalAccountGUID *string `json:"globalAccountGUID,omitempty"`
+
+	// Job is the last asynchronous operation started on the subaccount
+	// +optional
+	Job *AsyncJob `json:"job,omitempty"`
+
+	// Move is the last move of the subaccount to another directory or the global account
+	// +optional
+	Move *SubaccountMove `json:"move,omitempty"`
+}
+
+// SubaccountMovePhase is the progress of a subaccount move
+type SubaccountMovePhase string
+
+const (
+	// SubaccountMoving means the move has been requested, but the subaccount has not arrived at its target yet
+	SubaccountMoving SubaccountMovePhase = "Moving"
+	// SubaccountMoved means the subaccount arrived at its target
+	SubaccountMoved SubaccountMovePhase = "Moved"
+	// SubaccountMoveFailed means the move has been rejected or failed, it is retried with the next reconciliation
+	SubaccountMoveFailed SubaccountMovePhase = "Failed"
+)
+
+// SubaccountMove tracks a move of the subaccount. Moves of all subaccounts of a ProviderConfig are sent together in batches.
+type SubaccountMove struct {
+	// Phase of the move, one of Moving, Moved or Failed.
+	// Moves still Moving after 30 minutes fail and are requested again.
+	// +kubebuilder:validation:Enum=Moving;Moved;Failed
+	Phase SubaccountMovePhase `json:"phase"`
+	// SourceGuid is the directory or global account the subaccount is moved from
+	SourceGuid string `json:"sourceGuid"`
+	// TargetGuid is the directory or global account the subaccount is moved to
+	TargetGuid string `json:"targetGuid"`
+	// StartTime is the time the move has been requested
+	StartTime metav1.Time `json:"startTime"`
+	// Message contains the failure details of failed moves
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // A SubaccountSpec defines the desired state of a Subaccount.
+// Subaccounts imported with management policies that don't allow Create or Update may leave forProvider empty,
+// it is back-filled from the observed subaccount if LateInitialize is allowed.
+// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.displayName)",message="spec.forProvider.displayName is a required parameter"
+// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.region)",message="spec.forProvider.region is a required parameter"
+// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.subdomain)",message="spec.forProvider.subdomain is a required parameter"
+// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies) || has(self.forProvider.subaccountAdmins)",message="spec.forProvider.subaccountAdmins is a required parameter"
 type SubaccountSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       SubaccountParameters `json:"forProvider"`
@@ -141,9 +290,11 @@ type SubaccountStatus struct {
 // +kubebuilder:object:root=true
 
 // A Subaccount is a managed resource that represents a subaccount in the SAP Business Technology Platform
+// The GUID of the subaccount is stored as external-name, as long as it is not set a pre-existing subaccount with matching subdomain and region is adopted.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="MOVE",type="string",JSONPath=".status.atProvider.move.phase",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,sap}
@@ -175,3 +326,28 @@ var (
 func init() {
 	SchemeBuilder.Register(&Subaccount{}, &SubaccountList{})
 }
+
+const DeletionBlockedCondition xpv1.ConditionType = "DeletionBlocked"
+const DeletionProtectedReason xpv1.ConditionReason = "DeletionProtectionEnabled"
+const PreDeleteCheckMissingReason xpv1.ConditionReason = "PreDeleteCheckMissing"
+const RemainingResourcesReason xpv1.ConditionReason = "RemainingResourcesFound"
+const NoRemainingResourcesReason xpv1.ConditionReason = "NoRemainingResourcesFound"
+
+func DeletionBlocked(reason xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               DeletionBlockedCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            msg,
+	}
+}
+
+func DeletionNotBlocked() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               DeletionBlockedCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             NoRemainingResourcesReason,
+	}
+}
@@ -21,6 +21,9 @@ package v1alpha1
 // Hub marks this type as a conversion hub.
 func (tr *DirectoryEntitlement) Hub() {}
 
+// Hub marks this type as a conversion hub.
+func (tr *SubaccountEntitlement) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *SubaccountServiceBinding) Hub() {}
 
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -56,6 +57,26 @@ func (in *Assignable) DeepCopy() *Assignable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsyncJob) DeepCopyInto(out *AsyncJob) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.LastPollTime != nil {
+		in, out := &in.LastPollTime, &out.LastPollTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncJob.
+func (in *AsyncJob) DeepCopy() *AsyncJob {
+	if in == nil {
+		return nil
+	}
+	out := new(AsyncJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Binding) DeepCopyInto(out *Binding) {
 	*out = *in
@@ -143,6 +164,63 @@ func (in *Binding) DeepCopy() *Binding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogPlan) DeepCopyInto(out *CatalogPlan) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogPlan.
+func (in *CatalogPlan) DeepCopy() *CatalogPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogRegion) DeepCopyInto(out *CatalogRegion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogRegion.
+func (in *CatalogRegion) DeepCopy() *CatalogRegion {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogRegion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogService) DeepCopyInto(out *CatalogService) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]CatalogPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogService.
+func (in *CatalogService) DeepCopy() *CatalogService {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudManagement) DeepCopyInto(out *CloudManagement) {
 	*out = *in
@@ -343,6 +421,22 @@ func (in *Directory) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryAdminsApiCredentials) DeepCopyInto(out *DirectoryAdminsApiCredentials) {
+	*out = *in
+	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryAdminsApiCredentials.
+func (in *DirectoryAdminsApiCredentials) DeepCopy() *DirectoryAdminsApiCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryAdminsApiCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectoryEntitlement) DeepCopyInto(out *DirectoryEntitlement) {
 	*out = *in
@@ -370,6 +464,52 @@ func (in *DirectoryEntitlement) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryEntitlementAssignment) DeepCopyInto(out *DirectoryEntitlementAssignment) {
+	*out = *in
+	if in.Amount != nil {
+		in, out := &in.Amount, &out.Amount
+		*out = new(int)
+		**out = **in
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoDistributeAmount != nil {
+		in, out := &in.AutoDistributeAmount, &out.AutoDistributeAmount
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryEntitlementAssignment.
+func (in *DirectoryEntitlementAssignment) DeepCopy() *DirectoryEntitlementAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryEntitlementAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryEntitlementAssignmentObservation) DeepCopyInto(out *DirectoryEntitlementAssignmentObservation) {
+	*out = *in
+	in.Assigned.DeepCopyInto(&out.Assigned)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryEntitlementAssignmentObservation.
+func (in *DirectoryEntitlementAssignmentObservation) DeepCopy() *DirectoryEntitlementAssignmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryEntitlementAssignmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectoryEntitlementInitParameters) DeepCopyInto(out *DirectoryEntitlementInitParameters) {
 	*out = *in
@@ -677,11 +817,56 @@ func (in *DirectoryObservation) DeepCopyInto(out *DirectoryObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ParentGuid != nil {
+		in, out := &in.ParentGuid, &out.ParentGuid
+		*out = new(string)
+		**out = **in
+	}
 	if in.DirectoryFeatures != nil {
 		in, out := &in.DirectoryFeatures, &out.DirectoryFeatures
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DirectoryAdmins != nil {
+		in, out := &in.DirectoryAdmins, &out.DirectoryAdmins
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.ManagedDirectoryAdmins != nil {
+		in, out := &in.ManagedDirectoryAdmins, &out.ManagedDirectoryAdmins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EntitySettings != nil {
+		in, out := &in.EntitySettings, &out.EntitySettings
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Entitlements != nil {
+		in, out := &in.Entitlements, &out.Entitlements
+		*out = make([]DirectoryEntitlementAssignmentObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(AsyncJob)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryObservation.
@@ -707,6 +892,11 @@ func (in *DirectoryParameters) DeepCopyInto(out *DirectoryParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DirectoryAdminsApiCredentials != nil {
+		in, out := &in.DirectoryAdminsApiCredentials, &out.DirectoryAdminsApiCredentials
+		*out = new(DirectoryAdminsApiCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DirectoryFeatures != nil {
 		in, out := &in.DirectoryFeatures, &out.DirectoryFeatures
 		*out = make([]string, len(*in))
@@ -733,6 +923,27 @@ func (in *DirectoryParameters) DeepCopyInto(out *DirectoryParameters) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EntitySettings != nil {
+		in, out := &in.EntitySettings, &out.EntitySettings
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Entitlements != nil {
+		in, out := &in.Entitlements, &out.Entitlements
+		*out = make([]DirectoryEntitlementAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Subdomain != nil {
 		in, out := &in.Subdomain, &out.Subdomain
 		*out = new(string)
@@ -847,6 +1058,155 @@ func (in *Entitlement) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementCatalog) DeepCopyInto(out *EntitlementCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementCatalog.
+func (in *EntitlementCatalog) DeepCopy() *EntitlementCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EntitlementCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementCatalogList) DeepCopyInto(out *EntitlementCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EntitlementCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementCatalogList.
+func (in *EntitlementCatalogList) DeepCopy() *EntitlementCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EntitlementCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementCatalogObservation) DeepCopyInto(out *EntitlementCatalogObservation) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]CatalogService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]CatalogRegion, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementCatalogObservation.
+func (in *EntitlementCatalogObservation) DeepCopy() *EntitlementCatalogObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementCatalogObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementCatalogParameters) DeepCopyInto(out *EntitlementCatalogParameters) {
+	*out = *in
+	if in.ServiceNames != nil {
+		in, out := &in.ServiceNames, &out.ServiceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncInterval != nil {
+		in, out := &in.SyncInterval, &out.SyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementCatalogParameters.
+func (in *EntitlementCatalogParameters) DeepCopy() *EntitlementCatalogParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementCatalogParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementCatalogSpec) DeepCopyInto(out *EntitlementCatalogSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementCatalogSpec.
+func (in *EntitlementCatalogSpec) DeepCopy() *EntitlementCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementCatalogStatus) DeepCopyInto(out *EntitlementCatalogStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementCatalogStatus.
+func (in *EntitlementCatalogStatus) DeepCopy() *EntitlementCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EntitlementList) DeepCopyInto(out *EntitlementList) {
 	*out = *in
@@ -893,6 +1253,11 @@ func (in *EntitlementObservation) DeepCopyInto(out *EntitlementObservation) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Entitled.DeepCopyInto(&out.Entitled)
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaUsage)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementObservation.
@@ -1063,12 +1428,55 @@ func (in *GlobalAccount) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalAccountList) DeepCopyInto(out *GlobalAccountList) {
+func (in *GlobalAccountContract) DeepCopyInto(out *GlobalAccountContract) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
+	if in.UseFor != nil {
+		in, out := &in.UseFor, &out.UseFor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContractStatus != nil {
+		in, out := &in.ContractStatus, &out.ContractStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.TerminationNotificationStatus != nil {
+		in, out := &in.TerminationNotificationStatus, &out.TerminationNotificationStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackwardCompliantEU != nil {
+		in, out := &in.BackwardCompliantEU, &out.BackwardCompliantEU
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExpiryDate != nil {
+		in, out := &in.ExpiryDate, &out.ExpiryDate
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalDate != nil {
+		in, out := &in.RenewalDate, &out.RenewalDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAccountContract.
+func (in *GlobalAccountContract) DeepCopy() *GlobalAccountContract {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalAccountContract)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalAccountList) DeepCopyInto(out *GlobalAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
 		*out = make([]GlobalAccount, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
@@ -1097,6 +1505,43 @@ func (in *GlobalAccountList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalAccountObservation) DeepCopyInto(out *GlobalAccountObservation) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Subdomain != nil {
+		in, out := &in.Subdomain, &out.Subdomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.EntityState != nil {
+		in, out := &in.EntityState, &out.EntityState
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateMessage != nil {
+		in, out := &in.StateMessage, &out.StateMessage
+		*out = new(string)
+		**out = **in
+	}
+	in.Contract.DeepCopyInto(&out.Contract)
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]CatalogRegion, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAccountObservation.
@@ -1112,6 +1557,32 @@ func (in *GlobalAccountObservation) DeepCopy() *GlobalAccountObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalAccountParameters) DeepCopyInto(out *GlobalAccountParameters) {
 	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAccountParameters.
@@ -1128,7 +1599,7 @@ func (in *GlobalAccountParameters) DeepCopy() *GlobalAccountParameters {
 func (in *GlobalAccountSpec) DeepCopyInto(out *GlobalAccountSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAccountSpec.
@@ -1145,7 +1616,7 @@ func (in *GlobalAccountSpec) DeepCopy() *GlobalAccountSpec {
 func (in *GlobalAccountStatus) DeepCopyInto(out *GlobalAccountStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAccountStatus.
@@ -1270,6 +1741,21 @@ func (in *Instance) DeepCopy() *Instance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
@@ -1758,6 +2244,271 @@ func (in *Subaccount) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountAdminsApiCredentials) DeepCopyInto(out *SubaccountAdminsApiCredentials) {
+	*out = *in
+	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountAdminsApiCredentials.
+func (in *SubaccountAdminsApiCredentials) DeepCopy() *SubaccountAdminsApiCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountAdminsApiCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlement) DeepCopyInto(out *SubaccountEntitlement) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlement.
+func (in *SubaccountEntitlement) DeepCopy() *SubaccountEntitlement {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubaccountEntitlement) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlementInitParameters) DeepCopyInto(out *SubaccountEntitlementInitParameters) {
+	*out = *in
+	if in.Amount != nil {
+		in, out := &in.Amount, &out.Amount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.PlanName != nil {
+		in, out := &in.PlanName, &out.PlanName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubaccountID != nil {
+		in, out := &in.SubaccountID, &out.SubaccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubaccountRef != nil {
+		in, out := &in.SubaccountRef, &out.SubaccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubaccountSelector != nil {
+		in, out := &in.SubaccountSelector, &out.SubaccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlementInitParameters.
+func (in *SubaccountEntitlementInitParameters) DeepCopy() *SubaccountEntitlementInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlementInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlementList) DeepCopyInto(out *SubaccountEntitlementList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SubaccountEntitlement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlementList.
+func (in *SubaccountEntitlementList) DeepCopy() *SubaccountEntitlementList {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlementList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubaccountEntitlementList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlementObservation) DeepCopyInto(out *SubaccountEntitlementObservation) {
+	*out = *in
+	if in.Amount != nil {
+		in, out := &in.Amount, &out.Amount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = new(string)
+		**out = **in
+	}
+	if in.PlanID != nil {
+		in, out := &in.PlanID, &out.PlanID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PlanName != nil {
+		in, out := &in.PlanName, &out.PlanName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubaccountID != nil {
+		in, out := &in.SubaccountID, &out.SubaccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlementObservation.
+func (in *SubaccountEntitlementObservation) DeepCopy() *SubaccountEntitlementObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlementObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlementParameters) DeepCopyInto(out *SubaccountEntitlementParameters) {
+	*out = *in
+	if in.Amount != nil {
+		in, out := &in.Amount, &out.Amount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.PlanName != nil {
+		in, out := &in.PlanName, &out.PlanName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubaccountID != nil {
+		in, out := &in.SubaccountID, &out.SubaccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubaccountRef != nil {
+		in, out := &in.SubaccountRef, &out.SubaccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubaccountSelector != nil {
+		in, out := &in.SubaccountSelector, &out.SubaccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlementParameters.
+func (in *SubaccountEntitlementParameters) DeepCopy() *SubaccountEntitlementParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlementParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlementSpec) DeepCopyInto(out *SubaccountEntitlementSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlementSpec.
+func (in *SubaccountEntitlementSpec) DeepCopy() *SubaccountEntitlementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountEntitlementStatus) DeepCopyInto(out *SubaccountEntitlementStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountEntitlementStatus.
+func (in *SubaccountEntitlementStatus) DeepCopy() *SubaccountEntitlementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountEntitlementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubaccountList) DeepCopyInto(out *SubaccountList) {
 	*out = *in
@@ -1790,6 +2541,22 @@ func (in *SubaccountList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountMove) DeepCopyInto(out *SubaccountMove) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountMove.
+func (in *SubaccountMove) DeepCopy() *SubaccountMove {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountMove)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubaccountObservation) DeepCopyInto(out *SubaccountObservation) {
 	*out = *in
@@ -1843,6 +2610,20 @@ func (in *SubaccountObservation) DeepCopyInto(out *SubaccountObservation) {
 			}
 		}
 	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EntitySettings != nil {
+		in, out := &in.EntitySettings, &out.EntitySettings
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Region != nil {
 		in, out := &in.Region, &out.Region
 		*out = new(string)
@@ -1857,6 +2638,11 @@ func (in *SubaccountObservation) DeepCopyInto(out *SubaccountObservation) {
 			copy(*out, *in)
 		}
 	}
+	if in.ManagedSubaccountAdmins != nil {
+		in, out := &in.ManagedSubaccountAdmins, &out.ManagedSubaccountAdmins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Subdomain != nil {
 		in, out := &in.Subdomain, &out.Subdomain
 		*out = new(string)
@@ -1877,6 +2663,16 @@ func (in *SubaccountObservation) DeepCopyInto(out *SubaccountObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(AsyncJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Move != nil {
+		in, out := &in.Move, &out.Move
+		*out = new(SubaccountMove)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountObservation.
@@ -1908,11 +2704,35 @@ func (in *SubaccountParameters) DeepCopyInto(out *SubaccountParameters) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EntitySettings != nil {
+		in, out := &in.EntitySettings, &out.EntitySettings
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.SubaccountAdmins != nil {
 		in, out := &in.SubaccountAdmins, &out.SubaccountAdmins
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SubaccountAdminsApiCredentials != nil {
+		in, out := &in.SubaccountAdminsApiCredentials, &out.SubaccountAdminsApiCredentials
+		*out = new(SubaccountAdminsApiCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreDeleteCheck != nil {
+		in, out := &in.PreDeleteCheck, &out.PreDeleteCheck
+		*out = new(SubaccountPreDeleteCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GlobalAccountSelector != nil {
 		in, out := &in.GlobalAccountSelector, &out.GlobalAccountSelector
 		*out = new(v1.Selector)
@@ -1945,6 +2765,31 @@ func (in *SubaccountParameters) DeepCopy() *SubaccountParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountPreDeleteCheck) DeepCopyInto(out *SubaccountPreDeleteCheck) {
+	*out = *in
+	if in.CloudManagementSecretRef != nil {
+		in, out := &in.CloudManagementSecretRef, &out.CloudManagementSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.ServiceManagerSecretRef != nil {
+		in, out := &in.ServiceManagerSecretRef, &out.ServiceManagerSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountPreDeleteCheck.
+func (in *SubaccountPreDeleteCheck) DeepCopy() *SubaccountPreDeleteCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountPreDeleteCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubaccountServiceBinding) DeepCopyInto(out *SubaccountServiceBinding) {
 	*out = *in
@@ -47,6 +47,15 @@ func (l *DirectoryList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this EntitlementCatalogList.
+func (l *EntitlementCatalogList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this EntitlementList.
 func (l *EntitlementList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -92,6 +101,15 @@ func (l *ServiceManagerList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this SubaccountEntitlementList.
+func (l *SubaccountEntitlementList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SubaccountList.
 func (l *SubaccountList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -18,6 +18,12 @@ limitations under the License.
 
 package v1alpha1
 
+// Hub marks this type as a conversion hub.
+func (tr *DirectoryRoleCollection) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *DirectoryRoleCollectionAssignment) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *GlobalaccountTrustConfiguration) Hub() {}
 
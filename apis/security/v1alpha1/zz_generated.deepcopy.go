@@ -41,6 +41,525 @@ func (in *APICredentials) DeepCopy() *APICredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollection) DeepCopyInto(out *DirectoryRoleCollection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollection.
+func (in *DirectoryRoleCollection) DeepCopy() *DirectoryRoleCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectoryRoleCollection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignment) DeepCopyInto(out *DirectoryRoleCollectionAssignment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignment.
+func (in *DirectoryRoleCollectionAssignment) DeepCopy() *DirectoryRoleCollectionAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectoryRoleCollectionAssignment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignmentInitParameters) DeepCopyInto(out *DirectoryRoleCollectionAssignmentInitParameters) {
+	*out = *in
+	if in.AttributeName != nil {
+		in, out := &in.AttributeName, &out.AttributeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AttributeValue != nil {
+		in, out := &in.AttributeValue, &out.AttributeValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryID != nil {
+		in, out := &in.DirectoryID, &out.DirectoryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryRef != nil {
+		in, out := &in.DirectoryRef, &out.DirectoryRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirectorySelector != nil {
+		in, out := &in.DirectorySelector, &out.DirectorySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleCollectionName != nil {
+		in, out := &in.RoleCollectionName, &out.RoleCollectionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleCollectionRef != nil {
+		in, out := &in.RoleCollectionRef, &out.RoleCollectionRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleCollectionSelector != nil {
+		in, out := &in.RoleCollectionSelector, &out.RoleCollectionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignmentInitParameters.
+func (in *DirectoryRoleCollectionAssignmentInitParameters) DeepCopy() *DirectoryRoleCollectionAssignmentInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignmentInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignmentList) DeepCopyInto(out *DirectoryRoleCollectionAssignmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectoryRoleCollectionAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignmentList.
+func (in *DirectoryRoleCollectionAssignmentList) DeepCopy() *DirectoryRoleCollectionAssignmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectoryRoleCollectionAssignmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignmentObservation) DeepCopyInto(out *DirectoryRoleCollectionAssignmentObservation) {
+	*out = *in
+	if in.AttributeName != nil {
+		in, out := &in.AttributeName, &out.AttributeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AttributeValue != nil {
+		in, out := &in.AttributeValue, &out.AttributeValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryID != nil {
+		in, out := &in.DirectoryID, &out.DirectoryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleCollectionName != nil {
+		in, out := &in.RoleCollectionName, &out.RoleCollectionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignmentObservation.
+func (in *DirectoryRoleCollectionAssignmentObservation) DeepCopy() *DirectoryRoleCollectionAssignmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignmentParameters) DeepCopyInto(out *DirectoryRoleCollectionAssignmentParameters) {
+	*out = *in
+	if in.AttributeName != nil {
+		in, out := &in.AttributeName, &out.AttributeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AttributeValue != nil {
+		in, out := &in.AttributeValue, &out.AttributeValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryID != nil {
+		in, out := &in.DirectoryID, &out.DirectoryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryRef != nil {
+		in, out := &in.DirectoryRef, &out.DirectoryRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirectorySelector != nil {
+		in, out := &in.DirectorySelector, &out.DirectorySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleCollectionName != nil {
+		in, out := &in.RoleCollectionName, &out.RoleCollectionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleCollectionRef != nil {
+		in, out := &in.RoleCollectionRef, &out.RoleCollectionRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleCollectionSelector != nil {
+		in, out := &in.RoleCollectionSelector, &out.RoleCollectionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignmentParameters.
+func (in *DirectoryRoleCollectionAssignmentParameters) DeepCopy() *DirectoryRoleCollectionAssignmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignmentSpec) DeepCopyInto(out *DirectoryRoleCollectionAssignmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignmentSpec.
+func (in *DirectoryRoleCollectionAssignmentSpec) DeepCopy() *DirectoryRoleCollectionAssignmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionAssignmentStatus) DeepCopyInto(out *DirectoryRoleCollectionAssignmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionAssignmentStatus.
+func (in *DirectoryRoleCollectionAssignmentStatus) DeepCopy() *DirectoryRoleCollectionAssignmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionAssignmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionInitParameters) DeepCopyInto(out *DirectoryRoleCollectionInitParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryID != nil {
+		in, out := &in.DirectoryID, &out.DirectoryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryRef != nil {
+		in, out := &in.DirectoryRef, &out.DirectoryRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirectorySelector != nil {
+		in, out := &in.DirectorySelector, &out.DirectorySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RolesInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionInitParameters.
+func (in *DirectoryRoleCollectionInitParameters) DeepCopy() *DirectoryRoleCollectionInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionList) DeepCopyInto(out *DirectoryRoleCollectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectoryRoleCollection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionList.
+func (in *DirectoryRoleCollectionList) DeepCopy() *DirectoryRoleCollectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectoryRoleCollectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionObservation) DeepCopyInto(out *DirectoryRoleCollectionObservation) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryID != nil {
+		in, out := &in.DirectoryID, &out.DirectoryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RolesObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionObservation.
+func (in *DirectoryRoleCollectionObservation) DeepCopy() *DirectoryRoleCollectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionParameters) DeepCopyInto(out *DirectoryRoleCollectionParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryID != nil {
+		in, out := &in.DirectoryID, &out.DirectoryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryRef != nil {
+		in, out := &in.DirectoryRef, &out.DirectoryRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirectorySelector != nil {
+		in, out := &in.DirectorySelector, &out.DirectorySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RolesParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionParameters.
+func (in *DirectoryRoleCollectionParameters) DeepCopy() *DirectoryRoleCollectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionSpec) DeepCopyInto(out *DirectoryRoleCollectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionSpec.
+func (in *DirectoryRoleCollectionSpec) DeepCopy() *DirectoryRoleCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectoryRoleCollectionStatus) DeepCopyInto(out *DirectoryRoleCollectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryRoleCollectionStatus.
+func (in *DirectoryRoleCollectionStatus) DeepCopy() *DirectoryRoleCollectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectoryRoleCollectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalaccountTrustConfiguration) DeepCopyInto(out *GlobalaccountTrustConfiguration) {
 	*out = *in
@@ -572,6 +1091,96 @@ func (in *RoleReference) DeepCopy() *RoleReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolesInitParameters) DeepCopyInto(out *RolesInitParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleTemplateAppID != nil {
+		in, out := &in.RoleTemplateAppID, &out.RoleTemplateAppID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleTemplateName != nil {
+		in, out := &in.RoleTemplateName, &out.RoleTemplateName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolesInitParameters.
+func (in *RolesInitParameters) DeepCopy() *RolesInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RolesInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolesObservation) DeepCopyInto(out *RolesObservation) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleTemplateAppID != nil {
+		in, out := &in.RoleTemplateAppID, &out.RoleTemplateAppID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleTemplateName != nil {
+		in, out := &in.RoleTemplateName, &out.RoleTemplateName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolesObservation.
+func (in *RolesObservation) DeepCopy() *RolesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RolesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolesParameters) DeepCopyInto(out *RolesParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleTemplateAppID != nil {
+		in, out := &in.RoleTemplateAppID, &out.RoleTemplateAppID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleTemplateName != nil {
+		in, out := &in.RoleTemplateName, &out.RoleTemplateName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolesParameters.
+func (in *RolesParameters) DeepCopy() *RolesParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RolesParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubaccountApiCredential) DeepCopyInto(out *SubaccountApiCredential) {
 	*out = *in
@@ -20,6 +20,24 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this DirectoryRoleCollectionAssignmentList.
+func (l *DirectoryRoleCollectionAssignmentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this DirectoryRoleCollectionList.
+func (l *DirectoryRoleCollectionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this GlobalaccountTrustConfigurationList.
 func (l *GlobalaccountTrustConfigurationList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
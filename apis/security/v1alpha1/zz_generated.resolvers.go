@@ -21,11 +21,128 @@ package v1alpha1
 import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	resource "github.com/crossplane/upjet/pkg/resource"
 	errors "github.com/pkg/errors"
 	v1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this DirectoryRoleCollection.
+func (mg *DirectoryRoleCollection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DirectoryID),
+		Extract:      v1alpha1.DirectoryUuid(),
+		Reference:    mg.Spec.ForProvider.DirectoryRef,
+		Selector:     mg.Spec.ForProvider.DirectorySelector,
+		To: reference.To{
+			List:    &v1alpha1.DirectoryList{},
+			Managed: &v1alpha1.Directory{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DirectoryID")
+	}
+	mg.Spec.ForProvider.DirectoryID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DirectoryRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.DirectoryID),
+		Extract:      v1alpha1.DirectoryUuid(),
+		Reference:    mg.Spec.InitProvider.DirectoryRef,
+		Selector:     mg.Spec.InitProvider.DirectorySelector,
+		To: reference.To{
+			List:    &v1alpha1.DirectoryList{},
+			Managed: &v1alpha1.Directory{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.DirectoryID")
+	}
+	mg.Spec.InitProvider.DirectoryID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DirectoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DirectoryRoleCollectionAssignment.
+func (mg *DirectoryRoleCollectionAssignment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DirectoryID),
+		Extract:      v1alpha1.DirectoryUuid(),
+		Reference:    mg.Spec.ForProvider.DirectoryRef,
+		Selector:     mg.Spec.ForProvider.DirectorySelector,
+		To: reference.To{
+			List:    &v1alpha1.DirectoryList{},
+			Managed: &v1alpha1.Directory{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DirectoryID")
+	}
+	mg.Spec.ForProvider.DirectoryID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DirectoryRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleCollectionName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.RoleCollectionRef,
+		Selector:     mg.Spec.ForProvider.RoleCollectionSelector,
+		To: reference.To{
+			List:    &DirectoryRoleCollectionList{},
+			Managed: &DirectoryRoleCollection{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.RoleCollectionName")
+	}
+	mg.Spec.ForProvider.RoleCollectionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleCollectionRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.DirectoryID),
+		Extract:      v1alpha1.DirectoryUuid(),
+		Reference:    mg.Spec.InitProvider.DirectoryRef,
+		Selector:     mg.Spec.InitProvider.DirectorySelector,
+		To: reference.To{
+			List:    &v1alpha1.DirectoryList{},
+			Managed: &v1alpha1.Directory{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.DirectoryID")
+	}
+	mg.Spec.InitProvider.DirectoryID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DirectoryRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.RoleCollectionName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.RoleCollectionRef,
+		Selector:     mg.Spec.InitProvider.RoleCollectionSelector,
+		To: reference.To{
+			List:    &DirectoryRoleCollectionList{},
+			Managed: &DirectoryRoleCollection{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.RoleCollectionName")
+	}
+	mg.Spec.InitProvider.RoleCollectionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.RoleCollectionRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this RoleCollection.
 func (mg *RoleCollection) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
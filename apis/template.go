@@ -2,6 +2,7 @@
 package apis
 
 import (
+	namespacedaccountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/namespaced/v1alpha1"
 	accountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	accountv1beta1 "github.com/sap/crossplane-provider-btp/apis/account/v1beta1"
 	environmentv1alpha1 "github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
@@ -18,5 +19,6 @@ func init() {
 		environmentv1alpha1.SchemeBuilder.AddToScheme,
 		oidcv1alpha1.SchemeBuilder.AddToScheme,
 		accountv1beta1.SchemeBuilder.AddToScheme,
+		namespacedaccountv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
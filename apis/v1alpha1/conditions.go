@@ -0,0 +1,34 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIErrorCondition reports the class of the last error a BTP API returned for a managed resource.
+const APIErrorCondition xpv1.ConditionType = "APIError"
+
+// APIErrorResolvedReason is used once the managed resource has been reconciled without an API error again.
+const APIErrorResolvedReason xpv1.ConditionReason = "Resolved"
+
+// APIError returns a condition reporting an API error, its class is used as reason.
+func APIError(class string, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               APIErrorCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             xpv1.ConditionReason(class),
+		Message:            msg,
+	}
+}
+
+// APIErrorResolved returns a condition reporting that the last API error has been resolved.
+func APIErrorResolved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               APIErrorCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             APIErrorResolvedReason,
+	}
+}
@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// A NamespacedProviderConfig configures the provider for namespaced managed resources of the same namespace.
+// Referenced secrets must be located in the namespace of the NamespacedProviderConfig, a secretRef without namespace defaults to it.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.cisCredentials.secretRef.name",priority=1
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,provider,btp}
+type NamespacedProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedProviderConfigList contains a list of NamespacedProviderConfig.
+type NamespacedProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NamespacedProviderConfigUsage indicates that a namespaced resource is using a NamespacedProviderConfig of its namespace.
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".providerConfigRef.name"
+// +kubebuilder:printcolumn:name="RESOURCE-KIND",type="string",JSONPath=".resourceRef.kind"
+// +kubebuilder:printcolumn:name="RESOURCE-NAME",type="string",JSONPath=".resourceRef.name"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,provider,btp}
+type NamespacedProviderConfigUsage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	xpv1.ProviderConfigUsage `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedProviderConfigUsageList contains a list of NamespacedProviderConfigUsage
+type NamespacedProviderConfigUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedProviderConfigUsage `json:"items"`
+}
+
+// ToProviderConfig returns the NamespacedProviderConfig as ProviderConfig, so that it can be used by all clients.
+// Secret references are pinned to the namespace of the NamespacedProviderConfig.
+func (p *NamespacedProviderConfig) ToProviderConfig() *ProviderConfig {
+	pc := &ProviderConfig{
+		TypeMeta:   p.TypeMeta,
+		ObjectMeta: *p.ObjectMeta.DeepCopy(),
+		Spec:       *p.Spec.DeepCopy(),
+		Status:     *p.Status.DeepCopy(),
+	}
+	for _, cd := range []*ProviderCredentials{&pc.Spec.CISSecret, &pc.Spec.ServiceAccountSecret} {
+		if cd.SecretRef != nil && cd.SecretRef.Namespace == "" {
+			cd.SecretRef.Namespace = p.GetNamespace()
+		}
+	}
+	return pc
+}
+
+// NamespacedProviderConfig type metadata.
+var (
+	NamespacedProviderConfigKind             = reflect.TypeOf(NamespacedProviderConfig{}).Name()
+	NamespacedProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: NamespacedProviderConfigKind}.String()
+	NamespacedProviderConfigKindAPIVersion   = NamespacedProviderConfigKind + "." + SchemeGroupVersion.String()
+	NamespacedProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(NamespacedProviderConfigKind)
+
+	NamespacedProviderConfigUsageKind             = reflect.TypeOf(NamespacedProviderConfigUsage{}).Name()
+	NamespacedProviderConfigUsageGroupKind        = schema.GroupKind{Group: Group, Kind: NamespacedProviderConfigUsageKind}.String()
+	NamespacedProviderConfigUsageKindAPIVersion   = NamespacedProviderConfigUsageKind + "." + SchemeGroupVersion.String()
+	NamespacedProviderConfigUsageGroupVersionKind = SchemeGroupVersion.WithKind(NamespacedProviderConfigUsageKind)
+
+	NamespacedProviderConfigUsageListKind             = reflect.TypeOf(NamespacedProviderConfigUsageList{}).Name()
+	NamespacedProviderConfigUsageListGroupVersionKind = SchemeGroupVersion.WithKind(NamespacedProviderConfigUsageListKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NamespacedProviderConfig{}, &NamespacedProviderConfigList{})
+	SchemeBuilder.Register(&NamespacedProviderConfigUsage{}, &NamespacedProviderConfigUsageList{})
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -15,6 +16,11 @@ type ProviderConfigSpec struct {
 	// Reference to a secret containing the CIS Accounts service credentials.
 	// The Cloud Management (CIS) instance must be of plan `central`.
 	// The Service Binding should be created with the following parameters `{"grantType": "clientCredentials"}`
+	// Certificate based bindings (`"credential-type": "x509"` with certificate and key in the uaa block) are supported as well,
+	// tokens are then fetched via mTLS from the uaa cert url.
+	// Besides Secret, the credentials can be read from an Environment variable or the Filesystem, containing the json of the binding.
+	// A Filesystem path pointing to a directory (e.g. mounted by a CSI secret driver) is read with one file per binding attribute.
+	// With source InjectedIdentity no CIS secret is needed, the provider authenticates as configured in workloadIdentity.
 	// See [Setup](https://pages.github.tools.sap/cloud-orchestration/docs/sap-services/btp-services/account-managment/provider) for more details
 	CISSecret ProviderCredentials `json:"cisCredentials"`
 
@@ -35,6 +41,44 @@ type ProviderConfigSpec struct {
 
 	// GlobalAccount is the Global Account Subdomain.
 	GlobalAccount string `json:"globalAccount,omitempty"`
+
+	// WorkloadIdentity configures the exchange of the projected service account token of the provider
+	// for XSUAA tokens of the CIS instance, it is required if cisCredentials.source is InjectedIdentity.
+	// +optional
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+// WorkloadIdentity authenticates the provider with short-lived tokens instead of a CIS client secret.
+// The projected service account token is exchanged using the JWT bearer grant, which requires a trust
+// configuration for the issuer of the cluster in the identity zone of the CIS instance.
+type WorkloadIdentity struct {
+	// ClientID of the OAuth client of the CIS instance the service account token is exchanged for.
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientId"`
+
+	// AuthenticationURL is the url of the identity zone of the CIS instance, e.g. https://<subdomain>.authentication.eu10.hana.ondemand.com
+	// +kubebuilder:validation:MinLength=1
+	AuthenticationURL string `json:"authenticationUrl"`
+
+	// TokenPath is the path of the projected service account token inside the provider container.
+	// +kubebuilder:default:="/var/run/secrets/btp/serviceaccount/token"
+	// +optional
+	TokenPath string `json:"tokenPath,omitempty"`
+
+	// Endpoints of the CIS instance.
+	Endpoints CISEndpoints `json:"endpoints"`
+}
+
+// CISEndpoints are the service urls of a CIS instance of plan central.
+type CISEndpoints struct {
+	// +kubebuilder:validation:MinLength=1
+	AccountsServiceURL string `json:"accountsServiceUrl"`
+	// +kubebuilder:validation:MinLength=1
+	EntitlementsServiceURL string `json:"entitlementsServiceUrl"`
+	// +kubebuilder:validation:MinLength=1
+	ProvisioningServiceURL string `json:"provisioningServiceUrl"`
+	// +optional
+	SaasRegistryServiceURL string `json:"saasRegistryServiceUrl,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -49,12 +93,82 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// GlobalAccountGuid is the GUID of the global account the CIS credentials belong to.
+	// +optional
+	GlobalAccountGuid string `json:"globalAccountGuid,omitempty"`
+
+	// GlobalAccountSubdomain is the subdomain of the global account the CIS credentials belong to.
+	// +optional
+	GlobalAccountSubdomain string `json:"globalAccountSubdomain,omitempty"`
+
+	// CredentialType is the authentication method used with the CIS credentials, one of x509, clientCredentials, workloadIdentity or password.
+	// +optional
+	CredentialType string `json:"credentialType,omitempty"`
+
+	// TokenExpiry is the expiry of the OAuth token currently used for the CIS APIs.
+	// +optional
+	TokenExpiry *metav1.Time `json:"tokenExpiry,omitempty"`
+
+	// LastHealthCheckTime is the time the credentials have been checked last.
+	// +optional
+	LastHealthCheckTime *metav1.Time `json:"lastHealthCheckTime,omitempty"`
+}
+
+// TypeHealthy indicates whether the CIS APIs can be called with the credentials of a ProviderConfig.
+const TypeHealthy xpv1.ConditionType = "Healthy"
+
+// Reasons of the Ready and Healthy conditions of a ProviderConfig.
+const (
+	ReasonInvalidCredentials   xpv1.ConditionReason = "InvalidCredentials"
+	ReasonHealthCheckSucceeded xpv1.ConditionReason = "HealthCheckSucceeded"
+	ReasonHealthCheckFailed    xpv1.ConditionReason = "HealthCheckFailed"
+)
+
+// CredentialsValid returns a Ready condition indicating that the referenced credentials could be loaded and parsed.
+func CredentialsValid() xpv1.Condition {
+	return xpv1.Available()
+}
+
+// CredentialsInvalid returns a Ready condition indicating that the referenced credentials are missing or malformed.
+func CredentialsInvalid(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInvalidCredentials,
+		Message:            err.Error(),
+	}
+}
+
+// Healthy returns a Healthy condition indicating that a token could be fetched and the CIS APIs called.
+func Healthy() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthCheckSucceeded,
+	}
+}
+
+// Unhealthy returns a Healthy condition indicating that the CIS APIs could not be called with the credentials.
+func Unhealthy(reason xpv1.ConditionReason, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            err.Error(),
+	}
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures a Template provider.
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="HEALTHY",type="string",JSONPath=".status.conditions[?(@.type=='Healthy')].status"
+// +kubebuilder:printcolumn:name="GLOBALACCOUNT",type="string",JSONPath=".status.globalAccountSubdomain"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster
@@ -20,6 +20,26 @@ package v1alpha1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+// GetCondition of this NamespacedProviderConfig.
+func (p *NamespacedProviderConfig) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return p.Status.GetCondition(ct)
+}
+
+// GetUsers of this NamespacedProviderConfig.
+func (p *NamespacedProviderConfig) GetUsers() int64 {
+	return p.Status.Users
+}
+
+// SetConditions of this NamespacedProviderConfig.
+func (p *NamespacedProviderConfig) SetConditions(c ...xpv1.Condition) {
+	p.Status.SetConditions(c...)
+}
+
+// SetUsers of this NamespacedProviderConfig.
+func (p *NamespacedProviderConfig) SetUsers(i int64) {
+	p.Status.Users = i
+}
+
 // GetCondition of this ProviderConfig.
 func (p *ProviderConfig) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return p.Status.GetCondition(ct)
@@ -20,6 +20,26 @@ package v1alpha1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+// GetProviderConfigReference of this NamespacedProviderConfigUsage.
+func (p *NamespacedProviderConfigUsage) GetProviderConfigReference() xpv1.Reference {
+	return p.ProviderConfigReference
+}
+
+// GetResourceReference of this NamespacedProviderConfigUsage.
+func (p *NamespacedProviderConfigUsage) GetResourceReference() xpv1.TypedReference {
+	return p.ResourceReference
+}
+
+// SetProviderConfigReference of this NamespacedProviderConfigUsage.
+func (p *NamespacedProviderConfigUsage) SetProviderConfigReference(r xpv1.Reference) {
+	p.ProviderConfigReference = r
+}
+
+// SetResourceReference of this NamespacedProviderConfigUsage.
+func (p *NamespacedProviderConfigUsage) SetResourceReference(r xpv1.TypedReference) {
+	p.ResourceReference = r
+}
+
 // GetProviderConfigReference of this ProviderConfigUsage.
 func (p *ProviderConfigUsage) GetProviderConfigReference() xpv1.Reference {
 	return p.ProviderConfigReference
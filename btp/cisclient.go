@@ -2,19 +2,26 @@ package btp
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
-	"fmt"
+	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
 	accountsserviceclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	entitlementsserviceclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
 
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 )
@@ -23,6 +30,14 @@ const (
 	errInstanceDoesNotExist        = "cannot delete instance does not exist"
 	errCouldNotParseCISSecret      = "CIS Secret seems malformed"
 	errCouldNotParseUserCredential = "error while parsing sa-provider-secret JSON"
+
+	errMissingX509CertificateOrKey  = "x509 CIS binding requires certificate and key in uaa block"
+	errCouldNotParseX509Certificate = "could not parse certificate and key of x509 CIS binding"
+	errCouldNotCreateMTLSClient     = "cannot create mTLS client for x509 CIS binding"
+	errNoTokenSource                = "client has not been created from CIS credentials"
+	errReadServiceAccountToken      = "cannot read service account token for workload identity"
+	errWorkloadIdentityFromSecret   = "credential-type workload-identity is only supported with the InjectedIdentity source of a ProviderConfig"
+	errNoWorkloadIdentity           = "credentials of a workload identity client must be of credential-type workload-identity"
 )
 
 type InstanceParameters = map[string]interface{}
@@ -48,9 +63,15 @@ func CloudFoundryEnvironmentType() EnvironmentType {
 type Client struct {
 	AccountsServiceClient     *accountsserviceclient.APIClient
 	EntitlementsServiceClient *entitlementsserviceclient.ManageAssignedEntitlementsAPIService
+	RegionsServiceClient      *entitlementsserviceclient.RegionsForGlobalAccountAPIService
 	ProvisioningServiceClient provisioningclient.EnvironmentsAPI
+	SubscriptionsClient       saas_client.SubscriptionOperationsForAppConsumersAPI
 	AuthInfo                  runtime.ClientAuthInfoWriter
 	Credential                *Credentials
+
+	environments   *EnvironmentLookup
+	providerConfig *providerConfigLabel
+	tokenSource    oauth2.TokenSource
 }
 type Credentials struct {
 	UserCredential *UserCredential
@@ -75,26 +96,58 @@ type CISCredential struct {
 		ProvisioningServiceUrl      string `json:"provisioning_service_url"`
 		SaasRegistryServiceUrl      string `json:"saas_registry_service_url"`
 	} `json:"endpoints"`
-	GrantType       string `json:"grant_type"`
-	SapCloudService string `json:"sap.cloud.service"`
-	Uaa             struct {
-		Apiurl          string `json:"apiurl"`
-		Clientid        string `json:"clientid"`
-		Clientsecret    string `json:"clientsecret"`
-		CredentialType  string `json:"credential-type"`
-		Identityzone    string `json:"identityzone"`
-		Identityzoneid  string `json:"identityzoneid"`
-		Sburl           string `json:"sburl"`
-		Subaccountid    string `json:"subaccountid"`
-		Tenantid        string `json:"tenantid"`
-		Tenantmode      string `json:"tenantmode"`
-		Uaadomain       string `json:"uaadomain"`
-		Url             string `json:"url"`
-		Verificationkey string `json:"verificationkey"`
-		Xsappname       string `json:"xsappname"`
-		Xsmasterappname string `json:"xsmasterappname"`
-		Zoneid          string `json:"zoneid"`
-	} `json:"uaa"`
+	GrantType       string        `json:"grant_type"`
+	SapCloudService string        `json:"sap.cloud.service"`
+	Uaa             UaaCredential `json:"uaa"`
+}
+
+// UaaCredential is the uaa block of a CIS binding. Bindings of credential-type "x509" carry certificate and key
+// instead of a client secret and need to fetch tokens from the cert url via mTLS.
+type UaaCredential struct {
+	Apiurl          string `json:"apiurl"`
+	Certificate     string `json:"certificate,omitempty"`
+	Certurl         string `json:"certurl,omitempty"`
+	Clientid        string `json:"clientid"`
+	Clientsecret    string `json:"clientsecret"`
+	CredentialType  string `json:"credential-type"`
+	Identityzone    string `json:"identityzone"`
+	Identityzoneid  string `json:"identityzoneid"`
+	Key             string `json:"key,omitempty"`
+	Sburl           string `json:"sburl"`
+	Subaccountid    string `json:"subaccountid"`
+	Tenantid        string `json:"tenantid"`
+	Tenantmode      string `json:"tenantmode"`
+	Uaadomain       string `json:"uaadomain"`
+	Url             string `json:"url"`
+	Verificationkey string `json:"verificationkey"`
+	Xsappname       string `json:"xsappname"`
+	Xsmasterappname string `json:"xsmasterappname"`
+	Zoneid          string `json:"zoneid"`
+	// TokenFile is the projected service account token exchanged for tokens by credentials of credential-type "workload-identity".
+	// It is never read from json, workload identity is only configured by ProviderConfigs with source InjectedIdentity.
+	TokenFile string `json:"-"`
+}
+
+// IsX509 returns true if the binding authenticates with a client certificate instead of a client secret.
+func (u UaaCredential) IsX509() bool {
+	return u.CredentialType == credentialTypeX509
+}
+
+// IsWorkloadIdentity returns true if tokens are fetched by exchanging the service account token of the provider instead of a client secret.
+func (u UaaCredential) IsWorkloadIdentity() bool {
+	return u.CredentialType == credentialTypeWorkloadIdentity
+}
+
+// TLSCertificate parses the certificate chain and private key of a x509 binding.
+func (u UaaCredential) TLSCertificate() (tls.Certificate, error) {
+	if u.Certificate == "" || u.Key == "" {
+		return tls.Certificate{}, errors.New(errMissingX509CertificateOrKey)
+	}
+	cert, err := tls.X509KeyPair([]byte(u.Certificate), []byte(u.Key))
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, errCouldNotParseX509Certificate)
+	}
+	return cert, nil
 }
 
 type CloudFoundryOrg struct {
@@ -109,22 +162,79 @@ const (
 	KymaenvironmentParameterInstanceName = "name"
 	grantTypeClientCredentials           = "client_credentials"
 	grantTypePassword                    = "password"
+	grantTypeJWTBearer                   = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	credentialTypeX509                   = "x509"
+	credentialTypeWorkloadIdentity       = "workload-identity"
 	tokenURL                             = "/oauth/token"
 )
 
-func NewServiceClientWithCisCredential(credential *Credentials) Client {
+// Credential types as returned by Credentials.CredentialType.
+const (
+	CredentialTypeX509              = credentialTypeX509
+	CredentialTypeClientCredentials = "clientCredentials"
+	CredentialTypePassword          = "password"
+	CredentialTypeWorkloadIdentity  = "workloadIdentity"
+)
+
+// NewWorkloadIdentityCISCredential returns the CIS credential of a ProviderConfig authenticating with the projected
+// service account token in tokenFile, it contains no secret but all parameters to exchange the token.
+func NewWorkloadIdentityCISCredential(clientID string, authenticationURL string, tokenFile string) CISCredential {
+	credential := CISCredential{GrantType: grantTypeJWTBearer}
+	credential.Uaa = UaaCredential{
+		Clientid:       clientID,
+		Url:            authenticationURL,
+		CredentialType: credentialTypeWorkloadIdentity,
+		TokenFile:      tokenFile,
+	}
+	return credential
+}
+
+// NewServiceClientWithCisCredential creates a client from credentials read from a secret, workload identity credentials are rejected.
+func NewServiceClientWithCisCredential(credential *Credentials) (Client, error) {
+	if credential.CISCredential.Uaa.IsWorkloadIdentity() {
+		return Client{}, errors.New(errWorkloadIdentityFromSecret)
+	}
+	return newServiceClient(credential)
+}
+
+// NewWorkloadIdentityClient creates a client exchanging the service account token of the provider, the CIS credential
+// must have been created by NewWorkloadIdentityCISCredential from the spec of a ProviderConfig.
+func NewWorkloadIdentityClient(cisCredential CISCredential, userSecret []byte) (*Client, error) {
+	if !cisCredential.Uaa.IsWorkloadIdentity() || cisCredential.Uaa.TokenFile == "" {
+		return nil, errors.New(errNoWorkloadIdentity)
+	}
+	userCredential, err := parseUserCredential(userSecret)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newServiceClient(&Credentials{UserCredential: userCredential, CISCredential: &cisCredential})
+	if err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+func newServiceClient(credential *Credentials) (Client, error) {
 
 	authentication := authenticationParams(credential)
 
 	config := createConfig(credential, tokenURL, authentication)
 
-	client := createClient(credential, config)
-
-	return client
+	return createClient(credential, config)
 }
 
 func authenticationParams(credential *Credentials) url.Values {
 	params := url.Values{}
+	if credential.CISCredential.Uaa.IsWorkloadIdentity() {
+		// the assertion is read from the token file for every token request, since the kubelet rotates it
+		params.Add("grant_type", grantTypeJWTBearer)
+		return params
+	}
+	if credential.CISCredential.Uaa.IsX509() {
+		// client is authenticated by its certificate, client_id is sent along by the oauth2 config
+		params.Add("grant_type", grantTypeClientCredentials)
+		return params
+	}
 	if hasClientCredentials(credential) {
 		if isGrantTypeClientCredentials(credential) {
 			params.Add("username", credential.CISCredential.Uaa.Clientid)
@@ -152,19 +262,139 @@ func hasClientCredentials(credential *Credentials) bool {
 	return credential.CISCredential.Uaa.Clientid != ""
 }
 
-func createClient(credential *Credentials, config *clientcredentials.Config) Client {
+func createClient(credential *Credentials, config *clientcredentials.Config) (Client, error) {
+	providerConfig := newProviderConfigLabel("")
+	httpClient, tokenSource, err := createOAuthHTTPClient(credential, config, providerConfig)
+	if err != nil {
+		return Client{}, err
+	}
+	entitlementsClient := createEntitlementsServiceClient(credential, httpClient)
 	client := Client{
-		AccountsServiceClient:     createAccountsServiceClient(credential, config),
-		EntitlementsServiceClient: createEntitlementsServiceClient(credential, config),
-		ProvisioningServiceClient: createProvisioningServiceClient(credential, config),
+		AccountsServiceClient:     createAccountsServiceClient(credential, httpClient),
+		EntitlementsServiceClient: entitlementsClient.ManageAssignedEntitlementsAPI,
+		RegionsServiceClient:      entitlementsClient.RegionsForGlobalAccountAPI,
+		ProvisioningServiceClient: createProvisioningServiceClient(credential, httpClient),
+		SubscriptionsClient:       createSubscriptionsClient(credential, httpClient),
 		AuthInfo:                  GetBasicAuth(credential),
 		Credential:                credential,
+		providerConfig:            providerConfig,
+		tokenSource:               tokenSource,
+	}
+	return client, nil
+}
+
+// Token returns the OAuth token currently used by the client, a new one is fetched if it is expired.
+func (c *Client) Token() (*oauth2.Token, error) {
+	if c.tokenSource == nil {
+		return nil, errors.New(errNoTokenSource)
+	}
+	return c.tokenSource.Token()
+}
+
+// CredentialType returns the authentication method used with the CIS credentials, one of x509, clientCredentials, workloadIdentity or password.
+func (c *Credentials) CredentialType() string {
+	switch {
+	case c.CISCredential != nil && c.CISCredential.Uaa.IsWorkloadIdentity():
+		return CredentialTypeWorkloadIdentity
+	case c.CISCredential != nil && c.CISCredential.Uaa.IsX509():
+		return CredentialTypeX509
+	case c.CISCredential != nil && hasClientCredentials(c) && isGrantTypeClientCredentials(c):
+		return CredentialTypeClientCredentials
+	default:
+		return CredentialTypePassword
+	}
+}
+
+// SetProviderConfigName sets the ProviderConfig name the metrics of all requests of this client are labelled with.
+func (c *Client) SetProviderConfigName(name string) {
+	if c.providerConfig != nil {
+		c.providerConfig.set(name)
+	}
+}
+
+// apiForHost maps the hosts of the CIS service endpoints to their API family.
+func apiForHost(credential *Credentials) func(host string) string {
+	apis := map[string]string{}
+	endpoints := credential.CISCredential.Endpoints
+	for api, endpoint := range map[string]string{
+		APIAccounts:     endpoints.AccountsServiceUrl,
+		APIEntitlements: endpoints.EntitlementsServiceUrl,
+		APIProvisioning: endpoints.ProvisioningServiceUrl,
+		APISaaS:         endpoints.SaasRegistryServiceUrl,
+	} {
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			apis[u.Host] = api
+		}
+	}
+	return func(host string) string {
+		if api, ok := apis[host]; ok {
+			return api
+		}
+		return APICIS
 	}
-	return client
+}
+
+// createOAuthHTTPClient returns a http.Client backed by a single reusing token source, so that the accounts,
+// entitlements and provisioning clients share one token and only refresh it once it expires.
+// For x509 bindings tokens are fetched over mTLS using the certificate of the binding, an invalid certificate is an error,
+// for workload identity the service account token is exchanged with the JWT bearer grant.
+// All requests, including token requests, are subject to the per host rate limits and recorded as metrics.
+func createOAuthHTTPClient(credential *Credentials, config *clientcredentials.Config, providerConfig *providerConfigLabel) (*http.Client, oauth2.TokenSource, error) {
+	ctx := NewBackgroundContextWithDebugPrintHTTPClient()
+	if credential.CISCredential.Uaa.IsX509() {
+		mtlsClient, err := newMTLSHTTPClient(credential.CISCredential.Uaa)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errCouldNotCreateMTLSClient)
+		}
+		ctx = context.WithValue(context.Background(), oauth2.HTTPClient, mtlsClient)
+		ctx = AddDebugPrintHTTPClientToContext(ctx, WithHttpClient(mtlsClient))
+	}
+	ctx = addMetricsHTTPClientToContext(ctx, apiForHost(credential), providerConfig)
+	ctx = AddRateLimitedHTTPClientToContext(ctx)
+	tokenSource := config.TokenSource(ctx)
+	if credential.CISCredential.Uaa.IsWorkloadIdentity() {
+		tokenSource = oauth2.ReuseTokenSource(nil, &jwtBearerTokenSource{ctx: ctx, config: config, tokenFile: credential.CISCredential.Uaa.TokenFile})
+	}
+	return oauth2.NewClient(ctx, tokenSource), tokenSource, nil
+}
+
+// jwtBearerTokenSource exchanges the current content of tokenFile for an access token.
+type jwtBearerTokenSource struct {
+	ctx       context.Context
+	config    *clientcredentials.Config
+	tokenFile string
+}
+
+func (s *jwtBearerTokenSource) Token() (*oauth2.Token, error) {
+	assertion, err := os.ReadFile(filepath.Clean(s.tokenFile))
+	if err != nil {
+		return nil, errors.Wrap(err, errReadServiceAccountToken)
+	}
+	config := *s.config
+	config.EndpointParams = url.Values{}
+	for k, v := range s.config.EndpointParams {
+		config.EndpointParams[k] = v
+	}
+	config.EndpointParams.Set("assertion", strings.TrimSpace(string(assertion)))
+	return config.Token(s.ctx)
+}
+
+// newMTLSHTTPClient creates a http.Client presenting the certificate of the given x509 binding.
+func newMTLSHTTPClient(uaa UaaCredential) (*http.Client, error) {
+	cert, err := uaa.TLSCertificate()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return &http.Client{Transport: transport}, nil
 }
 
 func createProvisioningServiceClient(
-	credential *Credentials, config *clientcredentials.Config,
+	credential *Credentials, httpClient *http.Client,
 ) provisioningclient.EnvironmentsAPI {
 	provisioningServiceUrl, err := url.Parse(credential.CISCredential.Endpoints.ProvisioningServiceUrl)
 	if err != nil {
@@ -173,7 +403,7 @@ func createProvisioningServiceClient(
 
 	c := provisioningclient.NewConfiguration()
 
-	c.HTTPClient = config.Client(NewBackgroundContextWithDebugPrintHTTPClient())
+	c.HTTPClient = httpClient
 	c.Servers = []provisioningclient.ServerConfiguration{{URL: provisioningServiceUrl.String()}}
 
 	client := provisioningclient.NewAPIClient(c)
@@ -181,8 +411,41 @@ func createProvisioningServiceClient(
 	return client.EnvironmentsAPI
 }
 
+// createSubscriptionsClient returns nil if the binding has no valid SaaS registry URL
+func createSubscriptionsClient(
+	credential *Credentials, httpClient *http.Client,
+) saas_client.SubscriptionOperationsForAppConsumersAPI {
+	saasRegistryServiceUrl, err := url.Parse(credential.CISCredential.Endpoints.SaasRegistryServiceUrl)
+	if err != nil || saasRegistryServiceUrl.Host == "" {
+		return nil
+	}
+
+	c := saas_client.NewConfiguration()
+
+	c.HTTPClient = httpClient
+	c.Servers = []saas_client.ServerConfiguration{{URL: saasRegistryServiceUrl.String()}}
+
+	return saas_client.NewAPIClient(c).SubscriptionOperationsForAppConsumersAPI
+}
+
 func createConfig(credential *Credentials, tokenURL string, endPointParams url.Values) *clientcredentials.Config {
 	uaa := credential.CISCredential.Uaa
+	if uaa.IsWorkloadIdentity() {
+		return &clientcredentials.Config{
+			ClientID:       uaa.Clientid,
+			TokenURL:       uaa.Url + tokenURL,
+			EndpointParams: endPointParams,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}
+	}
+	if uaa.IsX509() {
+		return &clientcredentials.Config{
+			ClientID:       uaa.Clientid,
+			TokenURL:       uaa.Certurl + tokenURL,
+			EndpointParams: endPointParams,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}
+	}
 	config := &clientcredentials.Config{
 		ClientID:       uaa.Clientid,
 		ClientSecret:   uaa.Clientsecret,
@@ -192,26 +455,25 @@ func createConfig(credential *Credentials, tokenURL string, endPointParams url.V
 	return config
 }
 
+// createEntitlementsServiceClient returns an empty client if the service URL is invalid, so that its APIs are nil
 func createEntitlementsServiceClient(
-	cisCredential *Credentials, config *clientcredentials.Config,
-) *entitlementsserviceclient.ManageAssignedEntitlementsAPIService {
+	cisCredential *Credentials, httpClient *http.Client,
+) *entitlementsserviceclient.APIClient {
 	entitlementsServiceUrl, err := url.Parse(cisCredential.CISCredential.Endpoints.EntitlementsServiceUrl)
 	if err != nil {
-		return nil
+		return &entitlementsserviceclient.APIClient{}
 	}
 
 	c := entitlementsserviceclient.NewConfiguration()
 
-	c.HTTPClient = config.Client(NewBackgroundContextWithDebugPrintHTTPClient())
+	c.HTTPClient = httpClient
 	c.Servers = []entitlementsserviceclient.ServerConfiguration{{URL: entitlementsServiceUrl.String()}}
 
-	client := entitlementsserviceclient.NewAPIClient(c)
-
-	return client.ManageAssignedEntitlementsAPI
+	return entitlementsserviceclient.NewAPIClient(c)
 }
 
 func createAccountsServiceClient(
-	cisCredential *Credentials, config *clientcredentials.Config,
+	cisCredential *Credentials, httpClient *http.Client,
 ) *accountsserviceclient.APIClient {
 	accountServiceUrl, err := url.Parse(cisCredential.CISCredential.Endpoints.AccountsServiceUrl)
 	if err != nil {
@@ -220,7 +482,7 @@ func createAccountsServiceClient(
 
 	c := accountsserviceclient.NewConfiguration()
 
-	c.HTTPClient = config.Client(NewBackgroundContextWithDebugPrintHTTPClient())
+	c.HTTPClient = httpClient
 	c.Servers = []accountsserviceclient.ServerConfiguration{{URL: accountServiceUrl.String()}}
 
 	client := accountsserviceclient.NewAPIClient(c)
@@ -240,20 +502,34 @@ func ServiceClientFromSecret(cisSecret []byte, userSecret []byte) (Client, error
 	if err := json.Unmarshal(cisSecret, &cisCredential); err != nil {
 		return Client{}, errors.Wrap(err, errCouldNotParseCISSecret)
 	}
+	if cisCredential.Uaa.IsWorkloadIdentity() {
+		return Client{}, errors.New(errWorkloadIdentityFromSecret)
+	}
+	if cisCredential.Uaa.IsX509() {
+		if _, err := cisCredential.Uaa.TLSCertificate(); err != nil {
+			return Client{}, errors.Wrap(err, errCouldNotParseCISSecret)
+		}
+	}
 
-	var userCredential UserCredential
-
-	if err := json.Unmarshal(userSecret, &userCredential); err != nil {
-		return Client{}, errors.Wrap(err, errCouldNotParseUserCredential)
-
+	userCredential, err := parseUserCredential(userSecret)
+	if err != nil {
+		return Client{}, err
 	}
 
 	credential := &Credentials{
-		UserCredential: &userCredential,
+		UserCredential: userCredential,
 		CISCredential:  &cisCredential,
 	}
 
-	return NewServiceClientWithCisCredential(credential), nil
+	return NewServiceClientWithCisCredential(credential)
+}
+
+func parseUserCredential(userSecret []byte) (*UserCredential, error) {
+	var userCredential UserCredential
+	if err := json.Unmarshal(userSecret, &userCredential); err != nil {
+		return nil, errors.Wrap(err, errCouldNotParseUserCredential)
+	}
+	return &userCredential, nil
 }
 
 func (c *Client) CreateKymaEnvironment(ctx context.Context, instanceName string, planeName string, parameters InstanceParameters, resourceUID string, serviceAccountEmail string) (string, error) {
@@ -269,10 +545,11 @@ func (c *Client) CreateKymaEnvironment(ctx context.Context, instanceName string,
 		TechnicalKey:    nil,
 		User:            &serviceAccountEmail,
 	}
-	obj, _, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
+	obj, raw, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
+	c.invalidateEnvironments()
 
 	if err != nil {
-		return "", specifyAPIError(err)
+		return "", apierror.New(err, raw)
 	}
 
 	return *obj.Id, nil
@@ -284,9 +561,10 @@ func (c *Client) UpdateKymaEnvironment(ctx context.Context, environmentInstanceI
 		PlanName:   planeName,
 	}
 
-	_, _, err := c.ProvisioningServiceClient.UpdateEnvironmentInstance(ctx, environmentInstanceId).UpdateEnvironmentInstanceRequestPayload(payload).Execute()
+	_, raw, err := c.ProvisioningServiceClient.UpdateEnvironmentInstance(ctx, environmentInstanceId).UpdateEnvironmentInstanceRequestPayload(payload).Execute()
+	c.invalidateEnvironments()
 	if err != nil {
-		return specifyAPIError(err)
+		return apierror.New(err, raw)
 	}
 
 	return nil
@@ -318,9 +596,10 @@ func (c *Client) CreateCloudFoundryOrg(
 		TechnicalKey:    nil,
 		User:            &serviceAccountEmail,
 	}
-	localReturnValue, _, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
+	localReturnValue, raw, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
+	c.invalidateEnvironments()
 	if err != nil {
-		return "", specifyAPIError(err)
+		return "", apierror.New(err, raw)
 	}
 	createdOrg = *localReturnValue.Id
 	return createdOrg, nil
@@ -376,9 +655,10 @@ func (c *Client) getCloudFoundryEnvironmentId(ctx context.Context, instanceName
 }
 
 func (c *Client) DeleteEnvironmentById(ctx context.Context, environmentId string) error {
-	_, _, err := c.ProvisioningServiceClient.DeleteEnvironmentInstance(ctx, environmentId).Execute()
+	_, raw, err := c.ProvisioningServiceClient.DeleteEnvironmentInstance(ctx, environmentId).Execute()
+	c.invalidateEnvironments()
 	if err != nil {
-		return specifyAPIError(err)
+		return apierror.New(err, raw)
 	}
 	return nil
 }
@@ -386,13 +666,9 @@ func (c *Client) DeleteEnvironmentById(ctx context.Context, environmentId string
 func (c *Client) DeleteCloudFoundryEnvironment(ctx context.Context, instanceName string, orgName string) error {
 	environmentId, getErr := c.getCloudFoundryEnvironmentId(ctx, instanceName, orgName)
 	if getErr != nil {
-		return specifyAPIError(getErr)
+		return getErr
 	}
-	delErr := c.DeleteEnvironmentById(ctx, environmentId)
-	if delErr != nil {
-		return specifyAPIError(delErr)
-	}
-	return nil
+	return c.DeleteEnvironmentById(ctx, environmentId)
 }
 
 // First tries to get the environment by external name, if not found, it tries to get it by name and type
@@ -417,134 +693,53 @@ func (c *Client) GetEnvironment(
 func (c *Client) GetEnvironmentByNameAndType(
 	ctx context.Context, instanceName string, environmentType EnvironmentType,
 ) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
-	var environmentInstance *provisioningclient.BusinessEnvironmentInstanceResponseObject
-	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
-	response, _, err := c.ProvisioningServiceClient.GetEnvironmentInstances(ctx).Authorization("").Execute()
-
-	if err != nil {
-		return nil, specifyAPIError(err)
-	}
-
-	for _, instance := range response.EnvironmentInstances {
-		if instance.EnvironmentType != nil && *instance.EnvironmentType != environmentType.Identifier {
-			continue
-		}
-
-		var parameters string
-		var parameterList map[string]interface{}
-		if instance.Parameters != nil {
-			parameters = *instance.Parameters
-		}
-		err := json.Unmarshal([]byte(parameters), &parameterList)
-		if err != nil {
-			return nil, err
-		}
-		if parameterList[cfenvironmentParameterInstanceName] == instanceName {
-			environmentInstance = &instance
-			break
-		}
-		if parameterList[KymaenvironmentParameterInstanceName] == instanceName {
-			environmentInstance = &instance
-			break
-		}
-	}
-	return environmentInstance, err
+	return c.environmentLookup().ByParameter(
+		ctx, environmentType,
+		[]string{cfenvironmentParameterInstanceName, KymaenvironmentParameterInstanceName},
+		instanceName,
+	)
 }
 
 func (c *Client) GetEnvironmentById(
 	ctx context.Context, Id string,
 ) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
-
-	var environmentInstance *provisioningclient.BusinessEnvironmentInstanceResponseObject
-	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
-	response, _, err := c.ProvisioningServiceClient.GetEnvironmentInstances(ctx).Authorization("").Execute()
-
-	if err != nil {
-		return nil, specifyAPIError(err)
-	}
-
-	for _, instance := range response.EnvironmentInstances {
-
-		var parameters string
-		var parameterList map[string]interface{}
-		if instance.Parameters != nil {
-			parameters = *instance.Parameters
-		}
-		err := json.Unmarshal([]byte(parameters), &parameterList)
-		if err != nil {
-			return nil, err
-		}
-		if instance.Id != nil && *instance.Id == Id {
-			environmentInstance = &instance
-			break
-		}
-
-	}
-	return environmentInstance, err
-
+	return c.environmentLookup().ById(ctx, Id)
 }
 
 func (c *Client) GetCFEnvironmentByNameAndOrg(
 	ctx context.Context, instanceName string, orgName string,
 ) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
-	var environmentInstance *provisioningclient.BusinessEnvironmentInstanceResponseObject
-	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
-	envInstances, err := c.getCFEnvironments(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, instance := range envInstances {
-		if instance.EnvironmentType != nil && *instance.EnvironmentType != CloudFoundryEnvironmentType().Identifier {
-			continue
-		}
-
-		var parameters string
-		var parameterList map[string]interface{}
-		if instance.Parameters != nil {
-			parameters = *instance.Parameters
-		}
-		err := json.Unmarshal([]byte(parameters), &parameterList)
-		if err != nil {
-			return nil, err
-		}
-		if parameterList[cfenvironmentParameterInstanceName] == instanceName {
-			environmentInstance = &instance
-			break
-		}
-		if parameterList[cfenvironmentParameterInstanceName] == orgName {
-			environmentInstance = &instance
-			break
-		}
-	}
-	return environmentInstance, err
+	return c.environmentLookup().ByParameter(
+		ctx, CloudFoundryEnvironmentType(),
+		[]string{cfenvironmentParameterInstanceName},
+		instanceName, orgName,
+	)
 }
 
 func (c *Client) GetCFEnvironmentByOrgId(ctx context.Context, orgId string) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
-	var environmentInstance *provisioningclient.BusinessEnvironmentInstanceResponseObject
-	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
-	envInstances, err := c.getCFEnvironments(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, instance := range envInstances {
-		if instance.EnvironmentType != nil && *instance.EnvironmentType != CloudFoundryEnvironmentType().Identifier {
-			continue
-		}
-		if *instance.Id == orgId {
-			environmentInstance = &instance
-			break
-		}
+	return c.environmentLookup().ByIdAndType(ctx, orgId, CloudFoundryEnvironmentType())
+}
+
+// WithEnvironmentLookup returns a copy of the client that memoizes environment lookups.
+// Results are never refreshed by themselves, so a copy should only be used for a single reconcile.
+func (c Client) WithEnvironmentLookup() Client {
+	c.environments = NewEnvironmentLookup(c.ProvisioningServiceClient)
+	return c
+}
+
+// environmentLookup returns the memoizing lookup of the client or a fresh one, if the client has not been scoped via WithEnvironmentLookup
+func (c *Client) environmentLookup() *EnvironmentLookup {
+	if c.environments != nil {
+		return c.environments
 	}
-	return environmentInstance, err
+	return NewEnvironmentLookup(c.ProvisioningServiceClient)
 }
 
-func (c *Client) getCFEnvironments(ctx context.Context) ([]provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
-	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
-	response, _, err := c.ProvisioningServiceClient.GetEnvironmentInstances(ctx).Authorization("").Execute()
-	if err != nil {
-		return nil, specifyAPIError(err)
+// invalidateEnvironments drops memoized lookups after environments have been changed
+func (c *Client) invalidateEnvironments() {
+	if c.environments != nil {
+		c.environments.Invalidate()
 	}
-	return response.EnvironmentInstances, nil
 }
 
 func (c *Client) ExtractOrg(cfEnvironment *provisioningclient.BusinessEnvironmentInstanceResponseObject) (*CloudFoundryOrg, error) {
@@ -572,15 +767,3 @@ func (c *Client) GetBTPSubaccount(
 	btpSubaccount, _, err := c.AccountsServiceClient.SubaccountOperationsAPI.GetSubaccount(ctx, subaccountGUID).Execute()
 	return btpSubaccount, err
 }
-
-func specifyAPIError(err error) error {
-	if genericErr, ok := err.(*provisioningclient.GenericOpenAPIError); ok {
-		if provisionErr, ok := genericErr.Model().(provisioningclient.ApiExceptionResponseObject); ok {
-			return errors.New(fmt.Sprintf("API Error: %v, Code %v", provisionErr.Error.Message, provisionErr.Error.Code))
-		}
-		if genericErr.Body() != nil {
-			return errors.Wrap(err, string(genericErr.Body()))
-		}
-	}
-	return err
-}
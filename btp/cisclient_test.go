@@ -1,9 +1,27 @@
 package btp
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
 )
 
 func Test_authenticationParams(t *testing.T) {
@@ -22,24 +40,7 @@ func Test_authenticationParams(t *testing.T) {
 					UserCredential: &UserCredential{Email: "my@mail.com", Password: "mypassword"},
 					CISCredential: &CISCredential{
 						GrantType: "user_token",
-						Uaa: struct {
-							Apiurl          string `json:"apiurl"`
-							Clientid        string `json:"clientid"`
-							Clientsecret    string `json:"clientsecret"`
-							CredentialType  string `json:"credential-type"`
-							Identityzone    string `json:"identityzone"`
-							Identityzoneid  string `json:"identityzoneid"`
-							Sburl           string `json:"sburl"`
-							Subaccountid    string `json:"subaccountid"`
-							Tenantid        string `json:"tenantid"`
-							Tenantmode      string `json:"tenantmode"`
-							Uaadomain       string `json:"uaadomain"`
-							Url             string `json:"url"`
-							Verificationkey string `json:"verificationkey"`
-							Xsappname       string `json:"xsappname"`
-							Xsmasterappname string `json:"xsmasterappname"`
-							Zoneid          string `json:"zoneid"`
-						}{
+						Uaa: UaaCredential{
 							Clientid: "myclientid",
 						},
 					},
@@ -55,24 +56,7 @@ func Test_authenticationParams(t *testing.T) {
 				&Credentials{
 					CISCredential: &CISCredential{
 						GrantType: "client_credentials",
-						Uaa: struct {
-							Apiurl          string `json:"apiurl"`
-							Clientid        string `json:"clientid"`
-							Clientsecret    string `json:"clientsecret"`
-							CredentialType  string `json:"credential-type"`
-							Identityzone    string `json:"identityzone"`
-							Identityzoneid  string `json:"identityzoneid"`
-							Sburl           string `json:"sburl"`
-							Subaccountid    string `json:"subaccountid"`
-							Tenantid        string `json:"tenantid"`
-							Tenantmode      string `json:"tenantmode"`
-							Uaadomain       string `json:"uaadomain"`
-							Url             string `json:"url"`
-							Verificationkey string `json:"verificationkey"`
-							Xsappname       string `json:"xsappname"`
-							Xsmasterappname string `json:"xsmasterappname"`
-							Zoneid          string `json:"zoneid"`
-						}{
+						Uaa: UaaCredential{
 							Clientid:     "myclientid",
 							Clientsecret: "myclientsecret",
 						},
@@ -84,30 +68,28 @@ func Test_authenticationParams(t *testing.T) {
 				"grant_type": {"client_credentials"},
 			},
 		},
+		{
+			name: "Credential Type x509", args: args{
+				&Credentials{
+					CISCredential: &CISCredential{
+						GrantType: "client_credentials",
+						Uaa: UaaCredential{
+							Clientid:       "myclientid",
+							CredentialType: "x509",
+						},
+					},
+				},
+			}, want: map[string][]string{
+				"grant_type": {"client_credentials"},
+			},
+		},
 		{
 			name: "No client credentials", args: args{
 				&Credentials{
 					UserCredential: &UserCredential{Username: "myusername", Password: "mypassword"},
 					CISCredential: &CISCredential{
 						GrantType: "user_token",
-						Uaa: struct {
-							Apiurl          string `json:"apiurl"`
-							Clientid        string `json:"clientid"`
-							Clientsecret    string `json:"clientsecret"`
-							CredentialType  string `json:"credential-type"`
-							Identityzone    string `json:"identityzone"`
-							Identityzoneid  string `json:"identityzoneid"`
-							Sburl           string `json:"sburl"`
-							Subaccountid    string `json:"subaccountid"`
-							Tenantid        string `json:"tenantid"`
-							Tenantmode      string `json:"tenantmode"`
-							Uaadomain       string `json:"uaadomain"`
-							Url             string `json:"url"`
-							Verificationkey string `json:"verificationkey"`
-							Xsappname       string `json:"xsappname"`
-							Xsmasterappname string `json:"xsmasterappname"`
-							Zoneid          string `json:"zoneid"`
-						}{
+						Uaa: UaaCredential{
 							Clientid: "",
 						},
 					},
@@ -129,3 +111,210 @@ func Test_authenticationParams(t *testing.T) {
 		)
 	}
 }
+
+func Test_createConfigX509(t *testing.T) {
+	credential := &Credentials{
+		CISCredential: &CISCredential{
+			Uaa: UaaCredential{
+				Clientid:       "myclientid",
+				Clientsecret:   "ignored",
+				CredentialType: "x509",
+				Url:            "https://subdomain.authentication.sap.hana.ondemand.com",
+				Certurl:        "https://subdomain.authentication.cert.sap.hana.ondemand.com",
+			},
+		},
+	}
+	config := createConfig(credential, tokenURL, authenticationParams(credential))
+
+	assert.Equal(t, "https://subdomain.authentication.cert.sap.hana.ondemand.com/oauth/token", config.TokenURL)
+	assert.Equal(t, "myclientid", config.ClientID)
+	assert.Empty(t, config.ClientSecret)
+	assert.Equal(t, oauth2.AuthStyleInParams, config.AuthStyle)
+}
+
+func TestX509TokenFetchUsesClientCertificate(t *testing.T) {
+	certPEM, keyPEM := generateTestCertificate(t)
+
+	var presentedCerts int
+	var presentedClientID string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presentedCerts = len(r.TLS.PeerCertificates)
+		_ = r.ParseForm()
+		presentedClientID = r.PostForm.Get("client_id")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"abc","token_type":"bearer","expires_in":3600}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	uaa := UaaCredential{
+		Clientid:       "myclientid",
+		CredentialType: "x509",
+		Certurl:        server.URL,
+		Certificate:    string(certPEM),
+		Key:            string(keyPEM),
+	}
+	mtlsClient, err := newMTLSHTTPClient(uaa)
+	assert.NoError(t, err)
+	// trust the self-signed server certificate of the test server
+	mtlsClient.Transport.(*http.Transport).TLSClientConfig.RootCAs = server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs
+
+	credential := &Credentials{CISCredential: &CISCredential{Uaa: uaa}}
+	config := createConfig(credential, tokenURL, authenticationParams(credential))
+	token, err := config.Token(context.WithValue(context.Background(), oauth2.HTTPClient, mtlsClient))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", token.AccessToken)
+	assert.Equal(t, 1, presentedCerts)
+	assert.Equal(t, "myclientid", presentedClientID)
+}
+
+func TestSubscriptionsClientUsesTokenOfClient(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == tokenURL {
+			_, _ = w.Write([]byte(`{"access_token":"cis-token","token_type":"bearer","expires_in":3600}`))
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"applications":[]}`))
+	}))
+	defer server.Close()
+
+	cisCredential := &CISCredential{GrantType: grantTypeClientCredentials, Uaa: UaaCredential{Clientid: "myclientid", Clientsecret: "secret", Url: server.URL}}
+	cisCredential.Endpoints.SaasRegistryServiceUrl = server.URL
+
+	client, err := NewServiceClientWithCisCredential(&Credentials{UserCredential: &UserCredential{}, CISCredential: cisCredential})
+	assert.NoError(t, err)
+	_, _, err = client.SubscriptionsClient.GetEntitledApplications(context.Background()).Execute()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer cis-token", authorization)
+}
+
+func TestWorkloadIdentityExchangesServiceAccountToken(t *testing.T) {
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		requests = append(requests, r.PostForm)
+		w.Header().Set("Content-Type", "application/json")
+		// expires immediately, so that every Token call exchanges the current service account token
+		_, _ = w.Write([]byte(`{"access_token":"xsuaa-token","token_type":"bearer","expires_in":1}`))
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("sa-token-1\n"), 0o600))
+
+	cisCredential := NewWorkloadIdentityCISCredential("myclientid", server.URL, tokenFile)
+	cisCredential.Endpoints.AccountsServiceUrl = server.URL
+
+	client, err := NewWorkloadIdentityClient(cisCredential, []byte(`{"email":"1@sap.com"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, CredentialTypeWorkloadIdentity, client.Credential.CredentialType())
+
+	token, err := client.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "xsuaa-token", token.AccessToken)
+
+	// the kubelet rotates the projected token
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("sa-token-2"), 0o600))
+	_, err = client.Token()
+	assert.NoError(t, err)
+
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, grantTypeJWTBearer, requests[0].Get("grant_type"))
+		assert.Equal(t, "myclientid", requests[0].Get("client_id"))
+		assert.Equal(t, "sa-token-1", requests[0].Get("assertion"))
+		assert.Empty(t, requests[0].Get("client_secret"))
+		assert.Equal(t, "sa-token-2", requests[1].Get("assertion"))
+	}
+
+	assert.NoError(t, os.Remove(tokenFile))
+	_, err = client.Token()
+	assert.ErrorContains(t, err, errReadServiceAccountToken)
+}
+
+// Secrets may be written by tenants, they must not make the provider read and send its own files
+func TestSecretsRejectWorkloadIdentity(t *testing.T) {
+	cisSecret := []byte(`{"grant_type":"client_credentials","endpoints":{"accounts_service_url":"https://attacker.example.com"},` +
+		`"uaa":{"clientid":"xxx","credential-type":"workload-identity","tokenfile":"/var/run/secrets/kubernetes.io/serviceaccount/token","url":"https://attacker.example.com"}}`)
+	_, err := ServiceClientFromSecret(cisSecret, []byte(`{"email":"1@sap.com"}`))
+	assert.ErrorContains(t, err, errWorkloadIdentityFromSecret)
+
+	var cisCredential CISCredential
+	assert.NoError(t, json.Unmarshal(cisSecret, &cisCredential))
+	assert.Empty(t, cisCredential.Uaa.TokenFile, "the token file must never be read from json")
+	_, err = NewServiceClientWithCisCredential(&Credentials{UserCredential: &UserCredential{}, CISCredential: &cisCredential})
+	assert.ErrorContains(t, err, errWorkloadIdentityFromSecret)
+
+	_, err = NewWorkloadIdentityClient(cisCredential, []byte(`{"email":"1@sap.com"}`))
+	assert.ErrorContains(t, err, errNoWorkloadIdentity)
+}
+
+func TestServiceClientFromSecretRejectsInvalidX509(t *testing.T) {
+	cisSecret := []byte(`{"grant_type":"client_credentials","uaa":{"clientid":"xxx","credential-type":"x509","certificate":"no-pem","key":"no-pem"}}`)
+	_, err := ServiceClientFromSecret(cisSecret, []byte(`{"email":"1@sap.com"}`))
+	assert.ErrorContains(t, err, errCouldNotParseX509Certificate)
+}
+
+func TestNewServiceClientWithCisCredentialRejectsInvalidX509(t *testing.T) {
+	cisCredential := CISCredential{GrantType: grantTypeClientCredentials}
+	cisCredential.Uaa = UaaCredential{Clientid: "xxx", CredentialType: credentialTypeX509, Certificate: "no-pem", Key: "no-pem"}
+	_, err := NewServiceClientWithCisCredential(&Credentials{UserCredential: &UserCredential{}, CISCredential: &cisCredential})
+	assert.ErrorContains(t, err, errCouldNotCreateMTLSClient, "an invalid certificate must not fall back to client credentials")
+}
+
+func generateTestCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "myclientid"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestCredentials_CredentialType(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *Credentials
+		want       string
+	}{
+		{
+			name:       "x509",
+			credential: &Credentials{CISCredential: &CISCredential{GrantType: "client_credentials", Uaa: UaaCredential{Clientid: "id", CredentialType: "x509"}}},
+			want:       CredentialTypeX509,
+		},
+		{
+			name:       "client credentials",
+			credential: &Credentials{CISCredential: &CISCredential{GrantType: "client_credentials", Uaa: UaaCredential{Clientid: "id", Clientsecret: "secret"}}},
+			want:       CredentialTypeClientCredentials,
+		},
+		{
+			name:       "workload identity",
+			credential: &Credentials{CISCredential: &CISCredential{GrantType: grantTypeJWTBearer, Uaa: UaaCredential{Clientid: "id", CredentialType: "workload-identity"}}},
+			want:       CredentialTypeWorkloadIdentity,
+		},
+		{
+			name:       "password",
+			credential: &Credentials{UserCredential: &UserCredential{Email: "my@mail.com"}, CISCredential: &CISCredential{GrantType: "user_token", Uaa: UaaCredential{Clientid: "id"}}},
+			want:       CredentialTypePassword,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.credential.CredentialType())
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package btp
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// clientCacheIdleTTL evicts clients of ProviderConfigs that have not been used for a while, e.g. because they were deleted
+const clientCacheIdleTTL = time.Hour
+
+// ClientCache keeps one Client per ProviderConfig, so that the underlying token source and http connections
+// are reused across reconciles instead of fetching a new OAuth token on every Connect.
+// Entries are keyed by the ProviderConfig UID and get replaced as soon as the fingerprint of the
+// ProviderConfig and its referenced secrets changes. Entries not used within the idle TTL are evicted.
+type ClientCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]cachedClient
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+type cachedClient struct {
+	fingerprint string
+	client      *Client
+	lastUsed    time.Time
+}
+
+// NewClientCache creates an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{entries: map[types.UID]cachedClient{}, ttl: clientCacheIdleTTL, now: time.Now}
+}
+
+// Get returns the cached Client for the given ProviderConfig UID if its fingerprint is unchanged, otherwise it
+// creates a new one using newClientFn and replaces the previous entry.
+// Errors are never cached, an empty UID bypasses the cache entirely.
+func (c *ClientCache) Get(uid types.UID, fingerprint string, newClientFn func() (*Client, error)) (*Client, error) {
+	if uid == "" {
+		return newClientFn()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.evictIdle(now)
+	if entry, ok := c.entries[uid]; ok && entry.fingerprint == fingerprint {
+		entry.lastUsed = now
+		c.entries[uid] = entry
+		return entry.client, nil
+	}
+
+	client, err := newClientFn()
+	if err != nil {
+		delete(c.entries, uid)
+		return client, err
+	}
+	c.entries[uid] = cachedClient{fingerprint: fingerprint, client: client, lastUsed: now}
+	return client, nil
+}
+
+// evictIdle drops all entries not used within the TTL, the caller holds the lock.
+func (c *ClientCache) evictIdle(now time.Time) {
+	for uid, entry := range c.entries {
+		if now.Sub(entry.lastUsed) > c.ttl {
+			delete(c.entries, uid)
+		}
+	}
+}
+
+// Invalidate drops the cached Client of the given ProviderConfig UID.
+func (c *ClientCache) Invalidate(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, uid)
+}
+
+// Len returns the number of cached clients.
+func (c *ClientCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Fingerprint computes a stable hash over all given parts, each part is length prefixed to avoid collisions
+// between different splits of the same bytes.
+func Fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(part)))
+		h.Write(length[:])
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,75 @@
+package btp
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestClientCache_Get(t *testing.T) {
+	created := 0
+	newClientFn := func() (*Client, error) {
+		created++
+		return &Client{}, nil
+	}
+
+	cache := NewClientCache()
+
+	first, err := cache.Get("pc-uid", Fingerprint([]byte("cis"), []byte("sa")), newClientFn)
+	assert.NoError(t, err)
+	second, err := cache.Get("pc-uid", Fingerprint([]byte("cis"), []byte("sa")), newClientFn)
+	assert.NoError(t, err)
+	assert.Same(t, first, second, "expected client to be reused for unchanged fingerprint")
+	assert.Equal(t, 1, created)
+
+	third, err := cache.Get("pc-uid", Fingerprint([]byte("cis-rotated"), []byte("sa")), newClientFn)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, third, "expected client to be recreated for changed fingerprint")
+	assert.Equal(t, 2, created)
+	assert.Equal(t, 1, cache.Len())
+
+	cache.Invalidate("pc-uid")
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestClientCache_GetErrorsAndEmptyUID(t *testing.T) {
+	cache := NewClientCache()
+
+	_, err := cache.Get("pc-uid", "fp", func() (*Client, error) { return nil, errors.New("broken") })
+	assert.Error(t, err)
+	assert.Equal(t, 0, cache.Len(), "errors must not be cached")
+
+	created := 0
+	newClientFn := func() (*Client, error) {
+		created++
+		return &Client{}, nil
+	}
+	_, _ = cache.Get(types.UID(""), "fp", newClientFn)
+	_, _ = cache.Get(types.UID(""), "fp", newClientFn)
+	assert.Equal(t, 2, created, "empty UID must bypass the cache")
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestFingerprint(t *testing.T) {
+	assert.Equal(t, Fingerprint([]byte("a"), []byte("b")), Fingerprint([]byte("a"), []byte("b")))
+	assert.NotEqual(t, Fingerprint([]byte("ab"), []byte("")), Fingerprint([]byte("a"), []byte("b")))
+}
+
+func TestClientCache_EvictIdle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewClientCache()
+	cache.now = func() time.Time { return now }
+	newClientFn := func() (*Client, error) { return &Client{}, nil }
+
+	_, _ = cache.Get("deleted-pc", "fp", newClientFn)
+	now = now.Add(clientCacheIdleTTL / 2)
+	_, _ = cache.Get("used-pc", "fp", newClientFn)
+	assert.Equal(t, 2, cache.Len())
+
+	now = now.Add(clientCacheIdleTTL/2 + time.Minute)
+	_, _ = cache.Get("used-pc", "fp", newClientFn)
+	assert.Equal(t, 1, cache.Len(), "expected the client of the idle ProviderConfig to be evicted")
+}
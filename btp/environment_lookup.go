@@ -0,0 +1,131 @@
+package btp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+// EnvironmentLookup resolves environment instances of a subaccount.
+// Lookups by id use the direct get endpoint of the provisioning API, lookups by name fall back to the instance list.
+// Results are memoized for the lifetime of the lookup, so it is meant to be used for a single reconcile only.
+// Instances with malformed parameters are skipped instead of failing lookups of unrelated instances.
+type EnvironmentLookup struct {
+	client provisioningclient.EnvironmentsAPI
+
+	instances []provisioningclient.BusinessEnvironmentInstanceResponseObject
+	listed    bool
+	byId      map[string]*provisioningclient.BusinessEnvironmentInstanceResponseObject
+}
+
+// NewEnvironmentLookup creates an EnvironmentLookup with empty memo.
+func NewEnvironmentLookup(client provisioningclient.EnvironmentsAPI) *EnvironmentLookup {
+	return &EnvironmentLookup{
+		client: client,
+		byId:   map[string]*provisioningclient.BusinessEnvironmentInstanceResponseObject{},
+	}
+}
+
+// Invalidate drops all memoized results, needs to be called after any environment has been created, updated or deleted.
+func (l *EnvironmentLookup) Invalidate() {
+	l.instances = nil
+	l.listed = false
+	l.byId = map[string]*provisioningclient.BusinessEnvironmentInstanceResponseObject{}
+}
+
+// ById returns the environment instance with the given id or nil if it does not exist.
+func (l *EnvironmentLookup) ById(ctx context.Context, id string) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+	if id == "" {
+		return nil, nil
+	}
+	if instance, ok := l.byId[id]; ok {
+		return instance, nil
+	}
+	instance, resp, err := l.client.GetEnvironmentInstance(ctx, id).Execute()
+	if isNotFoundResponse(resp) {
+		l.byId[id] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apierror.New(err, resp)
+	}
+	l.byId[id] = instance
+	return instance, nil
+}
+
+// ByIdAndType returns the environment instance with the given id if it is of the given environment type, nil otherwise.
+func (l *EnvironmentLookup) ByIdAndType(ctx context.Context, id string, environmentType EnvironmentType) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+	instance, err := l.ById(ctx, id)
+	if err != nil || instance == nil {
+		return nil, err
+	}
+	if !isOfType(*instance, environmentType) {
+		return nil, nil
+	}
+	return instance, nil
+}
+
+// ByParameter returns the first environment instance of the given type that has any of the given values set as one of the given parameters.
+func (l *EnvironmentLookup) ByParameter(ctx context.Context, environmentType EnvironmentType, parameterNames []string, values ...string) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+	instances, err := l.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range instances {
+		instance := instances[i]
+		if !isOfType(instance, environmentType) {
+			continue
+		}
+		parameterList, ok := parseParameters(instance)
+		if !ok {
+			continue
+		}
+		for _, parameterName := range parameterNames {
+			for _, value := range values {
+				if parameterList[parameterName] == value {
+					return &instance, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// list fetches all environment instances of the subaccount once, the provisioning API does not offer paging or filtering for this endpoint.
+func (l *EnvironmentLookup) list(ctx context.Context) ([]provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+	if l.listed {
+		return l.instances, nil
+	}
+	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
+	response, raw, err := l.client.GetEnvironmentInstances(ctx).Authorization("").Execute()
+	if err != nil {
+		return nil, apierror.New(err, raw)
+	}
+	l.instances = response.EnvironmentInstances
+	l.listed = true
+	return l.instances, nil
+}
+
+// isOfType matches instances without environmentType as well to stay compatible with the previous lookups
+func isOfType(instance provisioningclient.BusinessEnvironmentInstanceResponseObject, environmentType EnvironmentType) bool {
+	return instance.EnvironmentType == nil || *instance.EnvironmentType == environmentType.Identifier
+}
+
+func parseParameters(instance provisioningclient.BusinessEnvironmentInstanceResponseObject) (map[string]interface{}, bool) {
+	if instance.Parameters == nil {
+		return nil, false
+	}
+	var parameterList map[string]interface{}
+	if err := json.Unmarshal([]byte(*instance.Parameters), &parameterList); err != nil {
+		return nil, false
+	}
+	return parameterList, true
+}
+
+// isNotFoundResponse is only true for unknown ids, other client errors like malformed requests are returned as errors
+func isNotFoundResponse(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
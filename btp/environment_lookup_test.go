@@ -0,0 +1,144 @@
+package btp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+type fakeEnvironmentsAPI struct {
+	provisioningclient.EnvironmentsAPI
+
+	instances   []provisioningclient.BusinessEnvironmentInstanceResponseObject
+	requestedId string
+	listCalls   int
+	getCalls    int
+	getStatus   int
+}
+
+func (f *fakeEnvironmentsAPI) GetEnvironmentInstances(ctx context.Context) provisioningclient.ApiGetEnvironmentInstancesRequest {
+	return provisioningclient.ApiGetEnvironmentInstancesRequest{ApiService: f}
+}
+
+func (f *fakeEnvironmentsAPI) GetEnvironmentInstancesExecute(r provisioningclient.ApiGetEnvironmentInstancesRequest) (*provisioningclient.BusinessEnvironmentInstancesResponseCollection, *http.Response, error) {
+	f.listCalls++
+	return &provisioningclient.BusinessEnvironmentInstancesResponseCollection{EnvironmentInstances: f.instances}, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func (f *fakeEnvironmentsAPI) GetEnvironmentInstance(ctx context.Context, environmentInstanceId string) provisioningclient.ApiGetEnvironmentInstanceRequest {
+	f.requestedId = environmentInstanceId
+	return provisioningclient.ApiGetEnvironmentInstanceRequest{ApiService: f}
+}
+
+func (f *fakeEnvironmentsAPI) GetEnvironmentInstanceExecute(r provisioningclient.ApiGetEnvironmentInstanceRequest) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, *http.Response, error) {
+	f.getCalls++
+	if f.getStatus != 0 {
+		return nil, &http.Response{StatusCode: f.getStatus}, errors.New(http.StatusText(f.getStatus))
+	}
+	for _, instance := range f.instances {
+		if internal.Val(instance.Id) == f.requestedId {
+			return &instance, &http.Response{StatusCode: http.StatusOK}, nil
+		}
+	}
+	return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("404 Not Found")
+}
+
+func testInstances() []provisioningclient.BusinessEnvironmentInstanceResponseObject {
+	return []provisioningclient.BusinessEnvironmentInstanceResponseObject{
+		{
+			Id:              internal.Ptr("broken"),
+			EnvironmentType: internal.Ptr("cloudfoundry"),
+			Parameters:      internal.Ptr("{not json"),
+		},
+		{
+			Id:              internal.Ptr("kyma-id"),
+			EnvironmentType: internal.Ptr("kyma"),
+			Parameters:      internal.Ptr(`{"name":"my-kyma"}`),
+		},
+		{
+			Id:              internal.Ptr("cf-id"),
+			EnvironmentType: internal.Ptr("cloudfoundry"),
+			Parameters:      internal.Ptr(`{"instance_name":"my-org"}`),
+		},
+	}
+}
+
+func TestEnvironmentLookup_ByParameterToleratesMalformedInstances(t *testing.T) {
+	api := &fakeEnvironmentsAPI{instances: testInstances()}
+	lookup := NewEnvironmentLookup(api)
+
+	cf, err := lookup.ByParameter(context.Background(), CloudFoundryEnvironmentType(), []string{cfenvironmentParameterInstanceName}, "my-org")
+	assert.NoError(t, err)
+	assert.Equal(t, "cf-id", internal.Val(cf.Id))
+
+	kyma, err := lookup.ByParameter(context.Background(), KymaEnvironmentType(), []string{KymaenvironmentParameterInstanceName}, "my-kyma")
+	assert.NoError(t, err)
+	assert.Equal(t, "kyma-id", internal.Val(kyma.Id))
+
+	none, err := lookup.ByParameter(context.Background(), KymaEnvironmentType(), []string{KymaenvironmentParameterInstanceName}, "my-org")
+	assert.NoError(t, err)
+	assert.Nil(t, none)
+
+	assert.Equal(t, 1, api.listCalls, "expected instance list to be memoized")
+}
+
+func TestEnvironmentLookup_ById(t *testing.T) {
+	api := &fakeEnvironmentsAPI{instances: testInstances()}
+	lookup := NewEnvironmentLookup(api)
+
+	instance, err := lookup.ById(context.Background(), "kyma-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "kyma-id", internal.Val(instance.Id))
+	_, _ = lookup.ById(context.Background(), "kyma-id")
+
+	missing, err := lookup.ById(context.Background(), "unknown")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	wrongType, err := lookup.ByIdAndType(context.Background(), "kyma-id", CloudFoundryEnvironmentType())
+	assert.NoError(t, err)
+	assert.Nil(t, wrongType)
+
+	empty, err := lookup.ById(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Nil(t, empty)
+
+	assert.Equal(t, 2, api.getCalls, "expected get by id to be memoized")
+	assert.Equal(t, 0, api.listCalls, "expected no list call for lookups by id")
+
+	lookup.Invalidate()
+	_, _ = lookup.ById(context.Background(), "kyma-id")
+	assert.Equal(t, 3, api.getCalls)
+}
+
+func TestEnvironmentLookup_ByIdReturnsBadRequest(t *testing.T) {
+	api := &fakeEnvironmentsAPI{instances: testInstances(), getStatus: http.StatusBadRequest}
+	lookup := NewEnvironmentLookup(api)
+
+	instance, err := lookup.ById(context.Background(), "not-a-guid")
+	assert.Error(t, err, "a malformed request must not be reported as a missing instance")
+	assert.Nil(t, instance)
+
+	_, _ = lookup.ById(context.Background(), "not-a-guid")
+	assert.Equal(t, 2, api.getCalls, "expected errors not to be memoized")
+}
+
+func TestClient_WithEnvironmentLookup(t *testing.T) {
+	api := &fakeEnvironmentsAPI{instances: testInstances()}
+
+	unscoped := Client{ProvisioningServiceClient: api}
+	_, _ = unscoped.GetCFEnvironmentByNameAndOrg(context.Background(), "", "my-org")
+	_, _ = unscoped.GetCFEnvironmentByNameAndOrg(context.Background(), "", "my-org")
+	assert.Equal(t, 2, api.listCalls)
+
+	scoped := unscoped.WithEnvironmentLookup()
+	_, _ = scoped.GetCFEnvironmentByNameAndOrg(context.Background(), "", "my-org")
+	_, _ = scoped.GetEnvironmentByNameAndType(context.Background(), "my-kyma", KymaEnvironmentType())
+	assert.Equal(t, 3, api.listCalls)
+}
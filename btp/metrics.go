@@ -0,0 +1,192 @@
+package btp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// API families used as api label of the outbound request metrics.
+const (
+	APIAccounts       = "accounts"
+	APIEntitlements   = "entitlements"
+	APIProvisioning   = "provisioning"
+	APISaaS           = "saas"
+	APIServiceManager = "servicemanager"
+	APIXsuaa          = "xsuaa"
+	// APICIS is used for requests of the CIS client to hosts that are not one of its service endpoints, e.g. its token endpoint.
+	APICIS = "cis"
+)
+
+const tokenPathSuffix = "/oauth/token"
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "btp",
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Number of requests sent to BTP APIs.",
+	}, []string{"api", "operation", "status_class", "provider_config"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "btp",
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests sent to BTP APIs.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"api", "operation", "status_class", "provider_config"})
+
+	tokenFetches = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "btp",
+		Subsystem: "oauth",
+		Name:      "token_fetches_total",
+		Help:      "Number of OAuth token fetches and refreshes for BTP APIs.",
+	}, []string{"api", "status_class", "provider_config"})
+
+	tokenFetchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "btp",
+		Subsystem: "oauth",
+		Name:      "token_fetch_duration_seconds",
+		Help:      "Latency of OAuth token fetches for BTP APIs.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"api", "status_class", "provider_config"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequests, apiRequestDuration, tokenFetches, tokenFetchDuration)
+}
+
+type providerConfigNameKey struct{}
+
+// ContextWithProviderConfigName sets the ProviderConfig name used as label by clients created with this context.
+func ContextWithProviderConfigName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, providerConfigNameKey{}, name)
+}
+
+func providerConfigNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(providerConfigNameKey{}).(string)
+	return name
+}
+
+// providerConfigLabel is shared between a Client and its transport, so that the ProviderConfig name can be set after creation.
+type providerConfigLabel struct {
+	name atomic.Value
+}
+
+func newProviderConfigLabel(name string) *providerConfigLabel {
+	l := &providerConfigLabel{}
+	l.set(name)
+	return l
+}
+
+func (l *providerConfigLabel) set(name string) {
+	l.name.Store(name)
+}
+
+func (l *providerConfigLabel) get() string {
+	if l == nil {
+		return ""
+	}
+	name, _ := l.name.Load().(string)
+	return name
+}
+
+// AddMetricsHTTPClientToContext wraps the transport of the http.Client stored in ctx with the oauth2.HTTPClient key
+// with a MetricsRoundTripper recording requests as the given API family.
+// The ProviderConfig label is taken from ContextWithProviderConfigName, it stays empty for clients authenticated by bindings.
+func AddMetricsHTTPClientToContext(ctx context.Context, api string) context.Context {
+	return addMetricsHTTPClientToContext(ctx, func(string) string { return api }, newProviderConfigLabel(providerConfigNameFromContext(ctx)))
+}
+
+func addMetricsHTTPClientToContext(ctx context.Context, apiForHost func(host string) string, providerConfig *providerConfigLabel) context.Context {
+	return wrapContextHTTPClient(ctx, func(base http.RoundTripper) http.RoundTripper {
+		if _, ok := base.(*MetricsRoundTripper); ok {
+			return nil
+		}
+		return &MetricsRoundTripper{base: base, apiForHost: apiForHost, providerConfig: providerConfig}
+	})
+}
+
+// MetricsRoundTripper records count and latency of every request, requests to token endpoints are recorded as token fetches.
+type MetricsRoundTripper struct {
+	base           http.RoundTripper
+	apiForHost     func(host string) string
+	providerConfig *providerConfigLabel
+}
+
+// RoundTrip calls the base RoundTripper and records the outcome.
+func (m *MetricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := m.base.RoundTrip(req)
+	elapsed := time.Since(start).Seconds()
+
+	api := m.apiForHost(req.URL.Host)
+	class := statusClass(resp, err)
+	providerConfig := m.providerConfig.get()
+	if strings.HasSuffix(req.URL.Path, tokenPathSuffix) {
+		tokenFetches.WithLabelValues(api, class, providerConfig).Inc()
+		tokenFetchDuration.WithLabelValues(api, class, providerConfig).Observe(elapsed)
+		return resp, err
+	}
+	operation := operationName(req)
+	apiRequests.WithLabelValues(api, operation, class, providerConfig).Inc()
+	apiRequestDuration.WithLabelValues(api, operation, class, providerConfig).Observe(elapsed)
+	return resp, err
+}
+
+func statusClass(resp *http.Response, err error) string {
+	if err != nil || resp == nil {
+		return "error"
+	}
+	switch {
+	case resp.StatusCode >= 500:
+		return "5xx"
+	case resp.StatusCode >= 400:
+		return "4xx"
+	case resp.StatusCode >= 300:
+		return "3xx"
+	default:
+		return "2xx"
+	}
+}
+
+// staticPathSegments are the fixed path elements of the BTP APIs used by the provider, taken from the paths of the
+// generated API clients and the token endpoint. Every other segment is considered a parameter.
+var staticPathSegments = func() map[string]bool {
+	set := map[string]bool{}
+	for _, segment := range []string{
+		"Groups", "Users", "accounts", "agents", "api", "application", "applications", "apps", "assignments",
+		"attributes", "authorities", "authorization", "authorizationData", "availableEnvironments", "batch",
+		"bindings", "btpcli", "bulk", "changeDirectoryFeatures", "clone", "current", "customProperties",
+		"directories", "entitlements", "environments", "globalAccount", "globalAccountAllowedDataCenters",
+		"globalAccountAssignments", "globalaccount", "globalaccountids", "ias", "id", "identity-providers", "jobs",
+		"jobs-management", "labels", "migrate", "move", "name", "neo", "oauth", "operations", "origin", "originKey",
+		"ownapp", "pages", "parameters", "platform-identity-providers", "platform-identity-providers-global",
+		"platformidentityproviders", "platforms", "provisioning", "public", "refreshTrusts", "rest", "result",
+		"rolecollections", "roles", "roletemplates", "rollback", "saas-manager", "sap", "scopes", "securitySettings",
+		"serviceManagementBinding", "serviceManagerBindings", "servicePlanAssignments", "service_bindings",
+		"service_brokers", "service_instances", "service_offerings", "service_plans", "settings", "status",
+		"subaccountServicePlans", "subaccounts", "subscription", "subscription-callback", "subscriptions",
+		"tenantLoginInfo", "tenants", "terminate", "token", "usage", "user", "users", "v1", "v2", "v2.0", "versions",
+		"zoneinfo",
+	} {
+		set[segment] = true
+	}
+	return set
+}()
+
+// operationName builds a low cardinality operation label from method and path by replacing ids and names with {param}.
+func operationName(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment != "" && !staticPathSegments[segment] {
+			segments[i] = "{param}"
+		}
+	}
+	return req.Method + " /" + strings.Join(segments, "/")
+}
@@ -0,0 +1,70 @@
+package btp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestMetricsRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := ContextWithProviderConfigName(context.Background(), "metrics-test")
+	ctx = AddMetricsHTTPClientToContext(ctx, APIXsuaa)
+	ctx = AddMetricsHTTPClientToContext(ctx, APIXsuaa)
+	client := ctx.Value(oauth2.HTTPClient).(*http.Client)
+
+	for _, path := range []string{"/sap/rest/authorization/v2/rolecollections/Subaccount%20Viewer", "/missing", "/oauth/token"} {
+		resp, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(apiRequests.WithLabelValues(APIXsuaa, "GET /sap/rest/authorization/v2/rolecollections/{param}", "2xx", "metrics-test")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(apiRequests.WithLabelValues(APIXsuaa, "GET /{param}", "4xx", "metrics-test")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(tokenFetches.WithLabelValues(APIXsuaa, "2xx", "metrics-test")))
+}
+
+func TestOperationName(t *testing.T) {
+	cases := map[string]string{
+		"/accounts/v1/subaccounts/0d8a9b4c-1f5e-4e0b-9a41-2f1c8f3e7d21":    "GET /accounts/v1/subaccounts/{param}",
+		"/saas-manager/v1/application/subscriptions":                       "GET /saas-manager/v1/application/subscriptions",
+		"/v1/service_offerings":                                            "GET /v1/service_offerings",
+		"/provisioning/v1/environments/ABCD-1234/bindings/some-binding-id": "GET /provisioning/v1/environments/{param}/bindings/{param}",
+		"/accounts/v1/directories/my-directory/users":                      "GET /accounts/v1/directories/{param}/users",
+		"/": "GET /",
+	}
+	for path, want := range cases {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.example.com"+path, nil)
+		assert.Equal(t, want, operationName(req), path)
+	}
+}
+
+func TestApiForHost(t *testing.T) {
+	credential := &Credentials{CISCredential: &CISCredential{}}
+	credential.CISCredential.Endpoints.AccountsServiceUrl = "https://accounts-service.example.com"
+	credential.CISCredential.Endpoints.ProvisioningServiceUrl = "https://provisioning-service.example.com/"
+
+	apiFor := apiForHost(credential)
+	assert.Equal(t, APIAccounts, apiFor("accounts-service.example.com"))
+	assert.Equal(t, APIProvisioning, apiFor("provisioning-service.example.com"))
+	assert.Equal(t, APICIS, apiFor("subdomain.authentication.example.com"))
+}
+
+func TestClientSetProviderConfigName(t *testing.T) {
+	client := Client{providerConfig: newProviderConfigLabel("")}
+	client.SetProviderConfigName("default")
+	assert.Equal(t, "default", client.providerConfig.get())
+
+	(&Client{}).SetProviderConfigName("no-label")
+}
@@ -0,0 +1,291 @@
+package btp
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
+
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+)
+
+// RateLimitOptions configures the RateLimitedRoundTripper.
+type RateLimitOptions struct {
+	// MaxConcurrentPerHost limits the number of in-flight requests per host, 0 disables the limit.
+	MaxConcurrentPerHost int
+	// QPSPerHost limits the requests per second per host, 0 disables the limit.
+	QPSPerHost float64
+	// BurstPerHost is the burst allowed on top of QPSPerHost.
+	BurstPerHost int
+	// MaxRetries is the maximum number of retries of a throttled or failed request.
+	MaxRetries int
+	// MinBackoff is the backoff before the first retry, it doubles with every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff between two retries.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After that is waited for, longer ones are returned to the caller.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRateLimitOptions returns the options used unless SetRateLimitOptions is called.
+func DefaultRateLimitOptions() RateLimitOptions {
+	return RateLimitOptions{
+		MaxConcurrentPerHost: 10,
+		QPSPerHost:           10,
+		BurstPerHost:         20,
+		MaxRetries:           3,
+		MinBackoff:           500 * time.Millisecond,
+		MaxBackoff:           10 * time.Second,
+		MaxRetryAfter:        time.Minute,
+	}
+}
+
+var (
+	rateLimitOptions = DefaultRateLimitOptions()
+	hostBudgets      = newHostBudgets()
+)
+
+// SetRateLimitOptions sets the options of all RateLimitedRoundTripper created afterwards.
+func SetRateLimitOptions(options RateLimitOptions) {
+	rateLimitOptions = options
+	hostBudgets = newHostBudgets()
+}
+
+// AddRateLimitedHTTPClientToContext wraps the transport of the http.Client stored in ctx with the oauth2.HTTPClient key
+// (or the default transport, if there is none) with a RateLimitedRoundTripper.
+// It composes with AddDebugPrintHTTPClientToContext, retries are logged as separate requests in that case.
+func AddRateLimitedHTTPClientToContext(ctx context.Context) context.Context {
+	return wrapContextHTTPClient(ctx, func(base http.RoundTripper) http.RoundTripper {
+		if _, ok := base.(*RateLimitedRoundTripper); ok {
+			return nil
+		}
+		return NewRateLimitedRoundTripper(base)
+	})
+}
+
+// wrapContextHTTPClient stores a copy of the http.Client in ctx with its transport wrapped by wrap.
+// wrap returns nil if the transport is already wrapped, ctx is returned unchanged in that case.
+func wrapContextHTTPClient(ctx context.Context, wrap func(base http.RoundTripper) http.RoundTripper) context.Context {
+	client := &http.Client{}
+	if existing, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && existing != nil {
+		clientCopy := *existing
+		client = &clientCopy
+	}
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped := wrap(base)
+	if wrapped == nil {
+		return ctx
+	}
+	client.Transport = wrapped
+	return context.WithValue(ctx, oauth2.HTTPClient, client)
+}
+
+// NewRateLimitedRoundTripper wraps base with the configured rate limit options, the per host budgets are shared by all instances.
+func NewRateLimitedRoundTripper(base http.RoundTripper) *RateLimitedRoundTripper {
+	return newRateLimitedRoundTripper(base, rateLimitOptions, hostBudgets)
+}
+
+func newRateLimitedRoundTripper(base http.RoundTripper, options RateLimitOptions, budgets *hostBudgetRegistry) *RateLimitedRoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RateLimitedRoundTripper{base: base, options: options, budgets: budgets, sleep: sleepContext}
+}
+
+// RateLimitedRoundTripper enforces a per host concurrency and QPS budget and retries throttled requests.
+// 429 responses are retried for all methods since the request has not been processed, 502, 503, 504 and
+// network errors only for idempotent methods. Retry-After is honored, otherwise a jittered exponential backoff is used.
+type RateLimitedRoundTripper struct {
+	base    http.RoundTripper
+	options RateLimitOptions
+	budgets *hostBudgetRegistry
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// RoundTrip sends the request within the budget of its host and retries it if allowed.
+func (r *RateLimitedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	budget := r.budgets.get(req.URL.Host, r.options)
+	for attempt := 0; ; attempt++ {
+		resp, err := r.roundTripWithinBudget(req, budget)
+
+		wait, retry := r.retryAfter(req, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if sleepErr := r.sleep(req.Context(), wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+		if req, err = rewindBody(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *RateLimitedRoundTripper) roundTripWithinBudget(req *http.Request, budget *hostBudget) (*http.Response, error) {
+	release, err := budget.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.base.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	// the slot is held until the body has been consumed by the caller
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// retryAfter decides whether the request is retried and how long to wait before.
+func (r *RateLimitedRoundTripper) retryAfter(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= r.options.MaxRetries || req.Context().Err() != nil || !isReplayable(req) {
+		return 0, false
+	}
+	if err != nil {
+		return r.backoff(attempt), isIdempotent(req.Method)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if wait, ok := apierror.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		return wait, wait <= r.options.MaxRetryAfter
+	}
+	return r.backoff(attempt), true
+}
+
+// backoff returns a duration between half and the full exponential backoff of the given attempt.
+func (r *RateLimitedRoundTripper) backoff(attempt int) time.Duration {
+	d := r.options.MinBackoff << attempt
+	if d <= 0 || d > r.options.MaxBackoff {
+		d = r.options.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func rewindBody(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	rewound := req.Clone(req.Context())
+	rewound.Body = body
+	return rewound, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+type hostBudgetRegistry struct {
+	mu      sync.Mutex
+	budgets map[string]*hostBudget
+}
+
+func newHostBudgets() *hostBudgetRegistry {
+	return &hostBudgetRegistry{budgets: map[string]*hostBudget{}}
+}
+
+func (h *hostBudgetRegistry) get(host string, options RateLimitOptions) *hostBudget {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if budget, ok := h.budgets[host]; ok {
+		return budget
+	}
+	budget := &hostBudget{}
+	if options.MaxConcurrentPerHost > 0 {
+		budget.slots = make(chan struct{}, options.MaxConcurrentPerHost)
+	}
+	if options.QPSPerHost > 0 {
+		burst := options.BurstPerHost
+		if burst < 1 {
+			burst = 1
+		}
+		budget.limiter = rate.NewLimiter(rate.Limit(options.QPSPerHost), burst)
+	}
+	h.budgets[host] = budget
+	return budget
+}
+
+type hostBudget struct {
+	slots   chan struct{}
+	limiter *rate.Limiter
+}
+
+// acquire blocks until the host has a free slot and QPS budget, the returned func releases the slot.
+func (b *hostBudget) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if b.slots != nil {
+		select {
+		case b.slots <- struct{}{}:
+			release = func() { <-b.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if b.limiter != nil {
+		if err := b.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,186 @@
+package btp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+// statusSequence serves the given status codes in order, the last one is repeated
+func statusSequence(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&calls, 1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(statuses[i])
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func testRoundTripper(options RateLimitOptions) (*RateLimitedRoundTripper, *[]time.Duration) {
+	var waits []time.Duration
+	rt := newRateLimitedRoundTripper(nil, options, newHostBudgets())
+	rt.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return rt, &waits
+}
+
+func TestRateLimitedRoundTripper_Retries(t *testing.T) {
+	cases := map[string]struct {
+		method     string
+		header     http.Header
+		statuses   []int
+		wantStatus int
+		wantCalls  int32
+		wantWaits  []time.Duration
+	}{
+		"GetRetriedOnRateLimitHonoringRetryAfter": {
+			method:     http.MethodGet,
+			header:     http.Header{"Retry-After": []string{"2"}},
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+			wantWaits:  []time.Duration{2 * time.Second},
+		},
+		"PostRetriedOnRateLimit": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusCreated},
+			wantStatus: http.StatusCreated,
+			wantCalls:  2,
+		},
+		"PostNotRetriedOnUnavailable": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusCreated},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  1,
+		},
+		"DeleteRetriedUntilMaxRetries": {
+			method:     http.MethodDelete,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  3,
+		},
+		"RetryAfterTooLong": {
+			method:     http.MethodGet,
+			header:     http.Header{"Retry-After": []string{"3600"}},
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  1,
+		},
+		"ClientErrorNotRetried": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadRequest, http.StatusOK},
+			wantStatus: http.StatusBadRequest,
+			wantCalls:  1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, calls := statusSequence(t, tc.header, tc.statuses...)
+			options := DefaultRateLimitOptions()
+			options.MaxRetries = 2
+			rt, waits := testRoundTripper(options)
+
+			req, _ := http.NewRequest(tc.method, server.URL, strings.NewReader(`{"some":"payload"}`))
+			resp, err := rt.RoundTrip(req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			assert.Equal(t, tc.wantCalls, atomic.LoadInt32(calls))
+			if tc.wantWaits != nil {
+				assert.Equal(t, tc.wantWaits, *waits)
+			}
+			for _, wait := range *waits {
+				assert.LessOrEqual(t, wait, options.MaxRetryAfter)
+			}
+		})
+	}
+}
+
+func TestRateLimitedRoundTripper_ConcurrencyPerHost(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer server.Close()
+
+	options := DefaultRateLimitOptions()
+	options.MaxConcurrentPerHost = 2
+	options.QPSPerHost = 0
+	client := &http.Client{Transport: newRateLimitedRoundTripper(nil, options, newHostBudgets())}
+
+	done := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+}
+
+func TestRateLimitedRoundTripper_ContextCanceledWhileWaitingForBudget(t *testing.T) {
+	options := DefaultRateLimitOptions()
+	options.QPSPerHost = 0.001
+	options.BurstPerHost = 1
+	rt := newRateLimitedRoundTripper(nil, options, newHostBudgets())
+	server, _ := statusSequence(t, nil, http.StatusOK)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err = rt.RoundTrip(req)
+	assert.Error(t, err)
+}
+
+func TestAddRateLimitedHTTPClientToContext_ComposesWithDebugClient(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+	SetDebug(true)
+	defer SetDebug(false)
+
+	ctx := AddDebugPrintHTTPClientToContext(context.Background(), WithHttpClient(&http.Client{}))
+	ctx = AddRateLimitedHTTPClientToContext(ctx)
+	ctx = AddRateLimitedHTTPClientToContext(ctx)
+
+	client := ctx.Value(oauth2.HTTPClient).(*http.Client)
+	rateLimited, ok := client.Transport.(*RateLimitedRoundTripper)
+	assert.True(t, ok, "expected rate limiting to be the outermost transport")
+	_, ok = rateLimited.base.(*RoundTripDebugger)
+	assert.True(t, ok, "expected rate limiting to wrap the debug transport")
+}
@@ -67,6 +67,23 @@ func main() {
 		).Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 
+		btpMaxConcurrentPerHost = app.Flag(
+			"btp-max-concurrent-requests-per-host",
+			"The maximum number of concurrent requests to a single BTP API host, 0 disables the limit.",
+		).Default("10").Envar("BTP_MAX_CONCURRENT_REQUESTS_PER_HOST").Int()
+		btpQPSPerHost = app.Flag(
+			"btp-qps-per-host",
+			"The maximum rate per second of requests to a single BTP API host, 0 disables the limit.",
+		).Default("10").Envar("BTP_QPS_PER_HOST").Float64()
+		btpBurstPerHost = app.Flag(
+			"btp-burst-per-host",
+			"The burst of requests to a single BTP API host allowed on top of btp-qps-per-host.",
+		).Default("20").Envar("BTP_BURST_PER_HOST").Int()
+		btpMaxRetries = app.Flag(
+			"btp-max-retries",
+			"How often throttled or failed requests to BTP APIs are retried before the error is returned to the reconciler.",
+		).Default("3").Envar("BTP_MAX_RETRIES").Int()
+
 		terraformVersion = app.Flag("terraform-version", "Terraform version.").Required().Envar("TERRAFORM_VERSION").String()
 		providerSource   = app.Flag("terraform-provider-source", "Terraform provider source.").Required().Envar("TERRAFORM_PROVIDER_SOURCE").String()
 		providerVersion  = app.Flag("terraform-provider-version", "Terraform provider version.").Required().Envar("TERRAFORM_PROVIDER_VERSION").String()
@@ -89,6 +106,13 @@ func main() {
 	btp.SetLogger(log)
 	btp.SetDebug(*debug)
 
+	rateLimitOptions := btp.DefaultRateLimitOptions()
+	rateLimitOptions.MaxConcurrentPerHost = *btpMaxConcurrentPerHost
+	rateLimitOptions.QPSPerHost = *btpQPSPerHost
+	rateLimitOptions.BurstPerHost = *btpBurstPerHost
+	rateLimitOptions.MaxRetries = *btpMaxRetries
+	btp.SetRateLimitOptions(rateLimitOptions)
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
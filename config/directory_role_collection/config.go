@@ -0,0 +1,52 @@
+package directory_role_collection
+
+import (
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Configure configures individual resources by adding custom ResourceConfigurators.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("btp_directory_role_collection", func(r *config.Resource) {
+		r.ShortGroup = "security"
+		r.Kind = "DirectoryRoleCollection"
+
+		// roles is a nested attribute of the plugin framework, the generator only understands it as block
+		r.TerraformResource.Schema["roles"] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "The roles of the role collection.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":                 {Type: schema.TypeString, Required: true, Description: "The name of the referenced role."},
+					"role_template_app_id": {Type: schema.TypeString, Required: true, Description: "The name of the referenced template app id."},
+					"role_template_name":   {Type: schema.TypeString, Required: true, Description: "The name of the referenced role template."},
+				},
+			},
+		}
+
+		r.References["directory_id"] = directoryReference()
+	})
+
+	p.AddResourceConfigurator("btp_directory_role_collection_assignment", func(r *config.Resource) {
+		r.ShortGroup = "security"
+		r.Kind = "DirectoryRoleCollectionAssignment"
+
+		r.References["directory_id"] = directoryReference()
+		r.References["role_collection_name"] = config.Reference{
+			Type:              "DirectoryRoleCollection",
+			Extractor:         `github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name", false)`,
+			RefFieldName:      "RoleCollectionRef",
+			SelectorFieldName: "RoleCollectionSelector",
+		}
+	})
+}
+
+func directoryReference() config.Reference {
+	return config.Reference{
+		Type:              "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Directory",
+		Extractor:         "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.DirectoryUuid()",
+		RefFieldName:      "DirectoryRef",
+		SelectorFieldName: "DirectorySelector",
+	}
+}
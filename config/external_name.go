@@ -4,18 +4,48 @@ Copyright 2022 Upbound Inc.
 
 package config
 
-import "github.com/crossplane/upjet/pkg/config"
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/upjet/pkg/config"
+)
 
 // ExternalNameConfigs contains all external name configurations for this
 // provider.
 var ExternalNameConfigs = map[string]config.ExternalName{
-	"btp_subaccount_trust_configuration":    config.IdentifierFromProvider,
-	"btp_globalaccount_trust_configuration": config.IdentifierFromProvider,
-	"btp_directory_entitlement":             config.IdentifierFromProvider,
-	"btp_subaccount_service_instance":       config.IdentifierFromProvider,
-	"btp_subaccount_service_binding":        config.IdentifierFromProvider,
-	"btp_subaccount_service_broker":         config.IdentifierFromProvider,
-	"btp_subaccount_api_credential":         config.IdentifierFromProvider,
+	"btp_subaccount_trust_configuration":       config.IdentifierFromProvider,
+	"btp_globalaccount_trust_configuration":    config.IdentifierFromProvider,
+	"btp_directory_entitlement":                config.IdentifierFromProvider,
+	"btp_subaccount_service_instance":          config.IdentifierFromProvider,
+	"btp_subaccount_service_binding":           config.IdentifierFromProvider,
+	"btp_subaccount_service_broker":            config.IdentifierFromProvider,
+	"btp_subaccount_api_credential":            config.IdentifierFromProvider,
+	"btp_directory_role_collection":            config.IdentifierFromProvider,
+	"btp_directory_role_collection_assignment": config.IdentifierFromProvider,
+	"btp_subaccount_entitlement":               subaccountEntitlementExternalName(),
+}
+
+// subaccountEntitlementExternalName uses the import ID of the terraform provider, <subaccount_id>,<service_name>,<plan_name>,
+// as external name. The ID assigned by the terraform provider is the one of the service plan, which is the same for all subaccounts.
+func subaccountEntitlementExternalName() config.ExternalName {
+	e := config.IdentifierFromProvider
+	e.GetExternalNameFn = func(tfstate map[string]any) (string, error) {
+		parts := make([]string, 0, 3)
+		for _, attr := range []string{"subaccount_id", "service_name", "plan_name"} {
+			v, ok := tfstate[attr].(string)
+			if !ok || v == "" {
+				return "", fmt.Errorf("cannot find %s in tfstate", attr)
+			}
+			parts = append(parts, v)
+		}
+		return strings.Join(parts, ","), nil
+	}
+	e.GetIDFn = func(_ context.Context, externalName string, _ map[string]any, _ map[string]any) (string, error) {
+		return externalName, nil
+	}
+	return e
 }
 
 // ExternalNameConfigurations applies all external name configs listed in the
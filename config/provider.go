@@ -11,7 +11,9 @@ import (
 	ujconfig "github.com/crossplane/upjet/pkg/config"
 	apicredentials "github.com/sap/crossplane-provider-btp/config/btp_subaccount_api_credential"
 	directoryentitlement "github.com/sap/crossplane-provider-btp/config/directory_entitlement"
+	directoryrolecollection "github.com/sap/crossplane-provider-btp/config/directory_role_collection"
 	globaltrustconfig "github.com/sap/crossplane-provider-btp/config/globalaccount_trust_configuration"
+	subaccountentitlement "github.com/sap/crossplane-provider-btp/config/subaccount_entitlement"
 	servicebinding "github.com/sap/crossplane-provider-btp/config/subaccount_service_binding"
 	servicebroker "github.com/sap/crossplane-provider-btp/config/subaccount_service_broker"
 	serviceinstance "github.com/sap/crossplane-provider-btp/config/subaccount_service_instance"
@@ -44,6 +46,8 @@ func GetProvider() *ujconfig.Provider {
 		trustconfig.Configure,
 		globaltrustconfig.Configure,
 		directoryentitlement.Configure,
+		subaccountentitlement.Configure,
+		directoryrolecollection.Configure,
 		serviceinstance.Configure,
 		servicebinding.Configure,
 		servicebroker.Configure,
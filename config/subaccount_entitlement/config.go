@@ -0,0 +1,20 @@
+package subaccount_entitlement
+
+import (
+	"github.com/crossplane/upjet/pkg/config"
+)
+
+// Configure configures individual resources by adding custom ResourceConfigurators.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("btp_subaccount_entitlement", func(r *config.Resource) {
+		r.ShortGroup = "account"
+		r.Kind = "SubaccountEntitlement"
+
+		r.References["subaccount_id"] = config.Reference{
+			Type:              "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Subaccount",
+			Extractor:         "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.SubaccountUuid()",
+			RefFieldName:      "SubaccountRef",
+			SelectorFieldName: "SubaccountSelector",
+		}
+	})
+}
@@ -0,0 +1,116 @@
+// Package accountmetadata maps custom properties and entity settings of subaccounts and directories
+// between the CRDs and the accounts service.
+package accountmetadata
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+const errInvalidSetting = "value of entity setting %s is not a json object"
+
+// LabelsWithCustomProperties returns the labels including the custom properties. The accounts service stores custom
+// properties as labels with a single value, so they need to be part of the labels sent and compared.
+func LabelsWithCustomProperties(labels map[string][]string, customProperties map[string]string) map[string][]string {
+	if len(customProperties) == 0 {
+		return labels
+	}
+	merged := make(map[string][]string, len(labels)+len(customProperties))
+	for k, v := range labels {
+		merged[k] = v
+	}
+	for k, v := range customProperties {
+		merged[k] = []string{v}
+	}
+	return merged
+}
+
+// CustomProperties maps the custom properties of an entity by key.
+func CustomProperties(properties []accountclient.PropertyResponseObject) map[string]string {
+	if len(properties) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(properties))
+	for _, p := range properties {
+		result[p.Key] = p.Value
+	}
+	return result
+}
+
+// ObservedSettings returns all entity settings with a user-defined value by key.
+func ObservedSettings(data *accountclient.DataResponseObject) (map[string]runtime.RawExtension, error) {
+	result := map[string]runtime.RawExtension{}
+	if data == nil {
+		return result, nil
+	}
+	for _, v := range data.Values {
+		if v.Value == nil {
+			continue
+		}
+		raw, err := json.Marshal(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		result[v.Key] = runtime.RawExtension{Raw: raw}
+	}
+	return result, nil
+}
+
+// SettingsDiff returns the settings to create or update and the keys of the settings to delete.
+// Nothing is managed as long as desired is nil.
+func SettingsDiff(desired, observed map[string]runtime.RawExtension) ([]accountclient.UpdateEntitySettingsRequestPayload, []string, error) {
+	if desired == nil {
+		return nil, nil, nil
+	}
+	var toUpdate []accountclient.UpdateEntitySettingsRequestPayload
+	for _, key := range sortedKeys(desired) {
+		want, err := settingValue(key, desired[key])
+		if err != nil {
+			return nil, nil, err
+		}
+		if current, ok := observed[key]; ok {
+			got, err := settingValue(key, current)
+			if err != nil {
+				return nil, nil, err
+			}
+			if reflect.DeepEqual(want, got) {
+				continue
+			}
+		}
+		toUpdate = append(toUpdate, accountclient.UpdateEntitySettingsRequestPayload{Key: key, Value: want})
+	}
+
+	var toDelete []string
+	for _, key := range sortedKeys(observed) {
+		if _, ok := desired[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+	return toUpdate, toDelete, nil
+}
+
+func settingValue(key string, raw runtime.RawExtension) (map[string]interface{}, error) {
+	value := map[string]interface{}{}
+	if len(raw.Raw) == 0 {
+		return value, nil
+	}
+	if err := json.Unmarshal(raw.Raw, &value); err != nil {
+		return nil, errors.Wrapf(err, errInvalidSetting, key)
+	}
+	return value, nil
+}
+
+func sortedKeys(m map[string]runtime.RawExtension) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
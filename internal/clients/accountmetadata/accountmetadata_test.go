@@ -0,0 +1,72 @@
+package accountmetadata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+func TestLabelsWithCustomProperties(t *testing.T) {
+	labels := map[string][]string{"team": {"a", "b"}}
+
+	if diff := cmp.Diff(labels, LabelsWithCustomProperties(labels, nil)); diff != "" {
+		t.Errorf("LabelsWithCustomProperties(...): -want, +got:\n%s\n", diff)
+	}
+	want := map[string][]string{"team": {"a", "b"}, "costCenter": {"4711"}}
+	if diff := cmp.Diff(want, LabelsWithCustomProperties(labels, map[string]string{"costCenter": "4711"})); diff != "" {
+		t.Errorf("LabelsWithCustomProperties(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestSettingsDiff(t *testing.T) {
+	raw := func(s string) runtime.RawExtension { return runtime.RawExtension{Raw: []byte(s)} }
+	observed, err := ObservedSettings(&accountclient.DataResponseObject{Values: []accountclient.PropertyDataResponseObject{
+		{Key: "owner", Value: map[string]interface{}{"name": "team-a"}},
+		{Key: "manual", Value: map[string]interface{}{"enabled": true}},
+		{Key: "defaulted"},
+	}})
+	if err != nil {
+		t.Fatalf("ObservedSettings(...): %v", err)
+	}
+
+	tests := map[string]struct {
+		desired    map[string]runtime.RawExtension
+		wantUpdate []accountclient.UpdateEntitySettingsRequestPayload
+		wantDelete []string
+		wantErr    bool
+	}{
+		"Unmanaged": {},
+		"InSync": {
+			desired: map[string]runtime.RawExtension{"owner": raw(`{"name": "team-a"}`), "manual": raw(`{"enabled":true}`)},
+		},
+		"ChangedAndRemoved": {
+			desired: map[string]runtime.RawExtension{"owner": raw(`{"name":"team-b"}`), "new": raw(`{"a":"b"}`)},
+			wantUpdate: []accountclient.UpdateEntitySettingsRequestPayload{
+				{Key: "new", Value: map[string]interface{}{"a": "b"}},
+				{Key: "owner", Value: map[string]interface{}{"name": "team-b"}},
+			},
+			wantDelete: []string{"manual"},
+		},
+		"NoObject": {
+			desired: map[string]runtime.RawExtension{"owner": raw(`"team-a"`)},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			toUpdate, toDelete, err := SettingsDiff(tc.desired, observed)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SettingsDiff(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.wantUpdate, toUpdate); diff != "" {
+				t.Errorf("SettingsDiff(...): toUpdate -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantDelete, toDelete); diff != "" {
+				t.Errorf("SettingsDiff(...): toDelete -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
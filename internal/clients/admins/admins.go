@@ -0,0 +1,116 @@
+// Package admins reconciles the users of the administrator role collection of a subaccount or directory via its xsuaa api.
+package admins
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/security/rolecollectionuserassignment"
+)
+
+const (
+	// DefaultIdentityOrigin is the origin of the users of the default identity provider
+	DefaultIdentityOrigin = "sap.default"
+
+	errCredentialsSource = "admins api credentials of namespaced resources must be a secret of namespace %s"
+	errListAdmins        = "cannot list admins"
+	errAssignAdmin       = "cannot assign admin %s"
+	errRevokeAdmin       = "cannot revoke admin %s"
+)
+
+// Accessor manages the users assigned to a role collection
+type Accessor interface {
+	ListUsers(ctx context.Context, origin, roleCollection string) ([]string, error)
+	AssignRole(ctx context.Context, origin, username, rolecollection string) error
+	RevokeRole(ctx context.Context, origin, username, rolecollection string) error
+}
+
+var _ Accessor = &rolecollectionuserassignment.XsusaaUserRoleAssigner{}
+
+// NewXsuaaAccessor creates an accessor for the xsuaa api of the binding
+func NewXsuaaAccessor(binding *securityv1alpha1.XsuaaBinding) Accessor {
+	return rolecollectionuserassignment.NewXsuaaUserRoleAssigner(btp.NewBackgroundContextWithDebugPrintHTTPClient(), binding.ClientId, binding.ClientSecret, binding.TokenURL, binding.ApiUrl)
+}
+
+// Binding reads the credentials of the xsuaa api, resources of the given namespace may only use secrets of that namespace
+func Binding(ctx context.Context, kube client.Client, namespace string, source xpv1.CredentialsSource, selectors xpv1.CommonCredentialSelectors) (*securityv1alpha1.XsuaaBinding, error) {
+	if namespace != "" && (source != xpv1.CredentialsSourceSecret || selectors.SecretRef == nil || selectors.SecretRef.Namespace != namespace) {
+		return nil, errors.Errorf(errCredentialsSource, namespace)
+	}
+	return securityv1alpha1.CreateBindingFromSource(&securityv1alpha1.XSUAACredentialsReference{
+		APICredentials: securityv1alpha1.APICredentials{
+			Source:                    source,
+			CommonCredentialSelectors: selectors,
+		},
+	}, ctx, kube)
+}
+
+// List returns the users of the default identity provider assigned to the role collection
+func List(ctx context.Context, accessor Accessor, roleCollection string) ([]string, error) {
+	users, err := accessor.ListUsers(ctx, DefaultIdentityOrigin, roleCollection)
+	return users, errors.Wrap(err, errListAdmins)
+}
+
+// Apply assigns the role collection to the users toAssign and revokes it from the users toRevoke
+func Apply(ctx context.Context, accessor Accessor, roleCollection string, toAssign, toRevoke []string) error {
+	for _, user := range toAssign {
+		if err := accessor.AssignRole(ctx, DefaultIdentityOrigin, user, roleCollection); err != nil {
+			return errors.Wrapf(err, errAssignAdmin, user)
+		}
+	}
+	for _, user := range toRevoke {
+		if err := accessor.RevokeRole(ctx, DefaultIdentityOrigin, user, roleCollection); err != nil {
+			return errors.Wrapf(err, errRevokeAdmin, user)
+		}
+	}
+	return nil
+}
+
+// Diff compares the desired admins with the actual users of the role collection, usernames are compared case-insensitive.
+// Only revocable users are revoked, the users to keep never are.
+func Diff(desired, actual, revocable, keep []string) (toAssign []string, toRevoke []string) {
+	desiredSet := lowerSet(desired)
+	actualSet := lowerSet(actual)
+	keepSet := lowerSet(keep)
+
+	for _, user := range desired {
+		if !actualSet[strings.ToLower(user)] {
+			toAssign = append(toAssign, user)
+		}
+	}
+	for _, user := range revocable {
+		lower := strings.ToLower(user)
+		if actualSet[lower] && !desiredSet[lower] && !keepSet[lower] {
+			toRevoke = append(toRevoke, user)
+		}
+	}
+	return toAssign, toRevoke
+}
+
+// TechnicalUsers returns the user the provider authenticates with, it must stay admin to manage the subaccount or directory
+func TechnicalUsers(credential *btp.Credentials) []string {
+	if credential == nil || credential.UserCredential == nil {
+		return nil
+	}
+	var users []string
+	for _, user := range []string{credential.UserCredential.Email, credential.UserCredential.Username} {
+		if user != "" {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+func lowerSet(users []string) map[string]bool {
+	set := make(map[string]bool, len(users))
+	for _, user := range users {
+		set[strings.ToLower(user)] = true
+	}
+	return set
+}
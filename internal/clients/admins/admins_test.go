@@ -0,0 +1,77 @@
+package admins
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		reason     string
+		desired    []string
+		actual     []string
+		revocable  []string
+		keep       []string
+		wantAssign []string
+		wantRevoke []string
+	}{
+		"InSyncIgnoringCase": {
+			reason:  "Usernames are compared case-insensitive",
+			desired: []string{"A@example.com"},
+			actual:  []string{"a@example.com"},
+		},
+		"AssignMissing": {
+			reason:     "Desired users missing in the role collection are assigned",
+			desired:    []string{"a@example.com", "b@example.com"},
+			actual:     []string{"a@example.com"},
+			wantAssign: []string{"b@example.com"},
+		},
+		"RevokeRevocableOnly": {
+			reason:     "Only revocable users, that are assigned and no longer desired, are revoked",
+			desired:    []string{"a@example.com"},
+			actual:     []string{"a@example.com", "removed@example.com", "manual@example.com"},
+			revocable:  []string{"a@example.com", "removed@example.com", "gone@example.com"},
+			wantRevoke: []string{"removed@example.com"},
+		},
+		"Keep": {
+			reason:    "Users to keep are never revoked",
+			desired:   []string{"a@example.com"},
+			actual:    []string{"a@example.com", "Provider@example.com"},
+			revocable: []string{"a@example.com", "Provider@example.com"},
+			keep:      []string{"provider@example.com"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			toAssign, toRevoke := Diff(tc.desired, tc.actual, tc.revocable, tc.keep)
+			if diff := cmp.Diff(tc.wantAssign, toAssign); diff != "" {
+				t.Errorf("\n%s\nDiff(...): toAssign -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantRevoke, toRevoke); diff != "" {
+				t.Errorf("\n%s\nDiff(...): toRevoke -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTechnicalUsers(t *testing.T) {
+	credential := &btp.Credentials{UserCredential: &btp.UserCredential{Email: "provider@example.com"}}
+	if diff := cmp.Diff([]string{"provider@example.com"}, TechnicalUsers(credential)); diff != "" {
+		t.Errorf("TechnicalUsers(...): -want, +got:\n%s\n", diff)
+	}
+	if got := TechnicalUsers(nil); got != nil {
+		t.Errorf("TechnicalUsers(...): want no users without credential, got %v", got)
+	}
+}
+
+func TestBindingOfOtherNamespace(t *testing.T) {
+	selectors := xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "xsuaa", Namespace: "team-b"}}}
+	if _, err := Binding(context.Background(), nil, "team-a", xpv1.CredentialsSourceSecret, selectors); err == nil {
+		t.Errorf("Binding(...): resources of a namespace must not read secrets of other namespaces")
+	}
+}
@@ -0,0 +1,231 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Class groups API errors by how a controller should react to them.
+type Class string
+
+const (
+	// Unknown errors could not be classified, they are retried like any other reconcile error.
+	Unknown Class = "Unknown"
+	// NotFound means the addressed entity does not exist (anymore).
+	NotFound Class = "NotFound"
+	// Conflict means the entity is currently locked or in a conflicting state, e.g. because of a running operation.
+	Conflict Class = "Conflict"
+	// RateLimited means the API rejected the request because too many requests have been sent.
+	RateLimited Class = "RateLimited"
+	// Unauthorized means the credentials are invalid or lack the required scopes.
+	Unauthorized Class = "Unauthorized"
+	// QuotaExceeded means the request would exceed entitled or assignable quota.
+	QuotaExceeded Class = "QuotaExceeded"
+	// Transient means a temporary server side or network failure.
+	Transient Class = "Transient"
+)
+
+// Retryable returns false for classes that won't resolve themselves without a change of spec or credentials.
+func (c Class) Retryable() bool {
+	switch c {
+	case NotFound, Unauthorized, QuotaExceeded:
+		return false
+	default:
+		return true
+	}
+}
+
+// Detail is a single nested error detail, as returned in NestingErrorDetailsResponseObject of the BTP APIs.
+type Detail struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// APIError is the typed representation of an error response of any of the BTP APIs.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response, 0 if unknown.
+	StatusCode int
+	// Code is the BTP error code.
+	Code int
+	// Message is the human readable error message.
+	Message string
+	// CorrelationID identifies the request in case of incidents.
+	CorrelationID string
+	// Target names the suspect of the error, if any.
+	Target string
+	// Details are nested error details.
+	Details []Detail
+	// Body is the raw response body, set if it could not be parsed into a structured error.
+	Body string
+	// RetryAfter is the delay requested by the Retry-After header of the response, 0 if none.
+	RetryAfter time.Duration
+
+	cause error
+}
+
+// Error keeps the format previously used by the clients of this provider.
+func (e *APIError) Error() string {
+	switch {
+	case e.Message != "":
+		return fmt.Sprintf("API Error: %s, Code %d", e.Message, e.Code)
+	case e.Body != "":
+		return fmt.Sprintf("API Error: %s", e.Body)
+	case e.cause != nil:
+		return e.cause.Error()
+	default:
+		return fmt.Sprintf("API Error: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+}
+
+// Unwrap returns the original error of the generated client.
+func (e *APIError) Unwrap() error {
+	return e.cause
+}
+
+// Class classifies the error by HTTP status and error message.
+func (e *APIError) Class() Class {
+	if e.StatusCode >= 400 && e.StatusCode < 500 && e.mentionsQuota() {
+		return QuotaExceeded
+	}
+	switch e.StatusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return NotFound
+	case http.StatusConflict, http.StatusLocked, http.StatusPreconditionFailed:
+		return Conflict
+	case http.StatusTooManyRequests:
+		return RateLimited
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return Unauthorized
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return Transient
+	}
+	return Unknown
+}
+
+func (e *APIError) mentionsQuota() bool {
+	messages := []string{e.Message}
+	for _, d := range e.Details {
+		messages = append(messages, d.Message)
+	}
+	for _, m := range messages {
+		if strings.Contains(strings.ToLower(m), "quota") {
+			return true
+		}
+	}
+	return false
+}
+
+// openAPIError is implemented by the GenericOpenAPIError of all generated BTP clients.
+type openAPIError interface {
+	error
+	Body() []byte
+	Model() interface{}
+}
+
+// errorEnvelope is the common error response format of the CIS, entitlements, provisioning and SaaS APIs.
+type errorEnvelope struct {
+	Error *struct {
+		Code          float64  `json:"code"`
+		Message       string   `json:"message"`
+		CorrelationID string   `json:"correlationID"`
+		Target        string   `json:"target"`
+		Details       []Detail `json:"details"`
+	} `json:"error"`
+}
+
+// New converts errors of the generated BTP API clients into an *APIError, classified by the status of resp.
+// resp is the *http.Response returned along with err by the generated client, it may be nil if no response was received.
+// All other errors are returned unchanged.
+func New(err error, resp *http.Response) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return err
+	}
+	var generic openAPIError
+	if !errors.As(err, &generic) {
+		return err
+	}
+
+	apiErr = &APIError{cause: err}
+	if resp != nil {
+		apiErr.StatusCode = resp.StatusCode
+		apiErr.RetryAfter, _ = ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+	body := generic.Body()
+	var envelope errorEnvelope
+	if len(body) > 0 && json.Unmarshal(body, &envelope) == nil && envelope.Error != nil {
+		apiErr.Code = int(envelope.Error.Code)
+		apiErr.Message = envelope.Error.Message
+		apiErr.CorrelationID = envelope.Error.CorrelationID
+		apiErr.Target = envelope.Error.Target
+		apiErr.Details = envelope.Error.Details
+	} else if len(body) > 0 {
+		apiErr.Body = string(body)
+	}
+	return apiErr
+}
+
+// From extracts the *APIError created by New from err, the bool is false if err does not contain one.
+func From(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if err != nil && errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// ParseRetryAfter supports both delay-seconds and HTTP-date values of the Retry-After header.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// ClassOf classifies any error, network timeouts are considered Transient.
+func ClassOf(err error) Class {
+	if apiErr, ok := From(err); ok {
+		return apiErr.Class()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return Transient
+	}
+	return Unknown
+}
+
+// IsNotFound returns true if err is a BTP API error of class NotFound.
+func IsNotFound(err error) bool {
+	return ClassOf(err) == NotFound
+}
+
+// IsConflict returns true if err is a BTP API error of class Conflict.
+func IsConflict(err error) bool {
+	return ClassOf(err) == Conflict
+}
+
+// IsRetryable returns false if retrying err without user interaction is pointless.
+func IsRetryable(err error) bool {
+	return ClassOf(err).Retryable()
+}
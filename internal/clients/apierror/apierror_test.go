@@ -0,0 +1,165 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+// accountsError performs a real request against a stub server to obtain a GenericOpenAPIError of the generated client
+// and converts it with the response like the clients of this provider do.
+func accountsError(t *testing.T, status int, contentType string, body string, header ...string) error {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		for i := 0; i+1 < len(header); i += 2 {
+			w.Header().Set(header[i], header[i+1])
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	config := accountclient.NewConfiguration()
+	config.Servers = accountclient.ServerConfigurations{{URL: server.URL}}
+	_, resp, err := accountclient.NewAPIClient(config).SubaccountOperationsAPI.GetSubaccount(context.Background(), "guid").Execute()
+	if err == nil {
+		t.Fatal("expected request to fail")
+	}
+	return New(err, resp)
+}
+
+func TestFrom(t *testing.T) {
+	type want struct {
+		ok            bool
+		status        int
+		code          int
+		message       string
+		correlationID string
+		retryAfter    time.Duration
+		class         Class
+		errString     string
+	}
+	cases := map[string]struct {
+		err  func(t *testing.T) error
+		want want
+	}{
+		"NotFoundWithErrorModel": {
+			err: func(t *testing.T) error {
+				return accountsError(t, http.StatusNotFound, "application/json",
+					`{"error":{"code":11006,"message":"Subaccount not found","correlationID":"abc"}}`)
+			},
+			want: want{ok: true, status: 404, code: 11006, message: "Subaccount not found", correlationID: "abc", class: NotFound,
+				errString: "API Error: Subaccount not found, Code 11006"},
+		},
+		"ConflictWithoutErrorModel": {
+			err: func(t *testing.T) error {
+				return accountsError(t, http.StatusConflict, "text/plain", "locked")
+			},
+			want: want{ok: true, status: 409, class: Conflict, errString: "API Error: locked"},
+		},
+		"QuotaInDetails": {
+			err: func(t *testing.T) error {
+				return accountsError(t, http.StatusBadRequest, "application/json",
+					`{"error":{"code":30004,"message":"Request failed","details":[{"code":1,"message":"Not enough quota"}]}}`)
+			},
+			want: want{ok: true, status: 400, code: 30004, message: "Request failed", class: QuotaExceeded,
+				errString: "API Error: Request failed, Code 30004"},
+		},
+		"RateLimited": {
+			err: func(t *testing.T) error {
+				return accountsError(t, http.StatusTooManyRequests, "text/plain", "", "Retry-After", "30")
+			},
+			want: want{ok: true, status: 429, retryAfter: 30 * time.Second, class: RateLimited, errString: "429 Too Many Requests"},
+		},
+		"StatusFromResponse": {
+			err: func(t *testing.T) error {
+				return accountsError(t, http.StatusNotFound, "text/plain", "500 is part of the body only")
+			},
+			want: want{ok: true, status: 404, class: NotFound, errString: "API Error: 500 is part of the body only"},
+		},
+		"ServiceUnavailable": {
+			err: func(t *testing.T) error {
+				return accountsError(t, http.StatusServiceUnavailable, "text/html", "<html>down</html>")
+			},
+			want: want{ok: true, status: 503, class: Transient, errString: "API Error: <html>down</html>"},
+		},
+		"WrappedAPIError": {
+			err: func(t *testing.T) error {
+				return fmt.Errorf("wrapped: %w", accountsError(t, http.StatusUnauthorized, "text/plain", "bad token"))
+			},
+			want: want{ok: true, status: 401, class: Unauthorized, errString: "API Error: bad token"},
+		},
+		"NoAPIError": {
+			err: func(t *testing.T) error {
+				return errors.New("some error")
+			},
+			want: want{ok: false, class: Unknown},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.err(t)
+			apiErr, ok := From(err)
+			assert.Equal(t, tc.want.ok, ok)
+			assert.Equal(t, tc.want.class, ClassOf(err))
+			if !tc.want.ok {
+				assert.Same(t, err, New(err, nil), "expected non API errors to be returned unchanged")
+				return
+			}
+			assert.Equal(t, tc.want.status, apiErr.StatusCode)
+			assert.Equal(t, tc.want.retryAfter, apiErr.RetryAfter)
+			assert.Equal(t, tc.want.code, apiErr.Code)
+			assert.Equal(t, tc.want.message, apiErr.Message)
+			assert.Equal(t, tc.want.correlationID, apiErr.CorrelationID)
+			assert.Equal(t, tc.want.errString, apiErr.Error())
+
+			var generic *accountclient.GenericOpenAPIError
+			assert.True(t, errors.As(err, &generic), "expected original error to stay accessible")
+			assert.Same(t, err, New(err, nil), "expected converted errors to be returned unchanged")
+		})
+	}
+}
+
+func TestHelpers(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	conflict := &APIError{StatusCode: http.StatusConflict}
+	wrapped := fmt.Errorf("wrapped: %w", notFound)
+
+	assert.True(t, IsNotFound(notFound))
+	assert.True(t, IsNotFound(wrapped))
+	assert.False(t, IsNotFound(conflict))
+	assert.True(t, IsConflict(conflict))
+	assert.False(t, IsConflict(nil))
+
+	assert.False(t, IsRetryable(notFound))
+	assert.False(t, IsRetryable(&APIError{StatusCode: http.StatusForbidden}))
+	assert.True(t, IsRetryable(conflict))
+	assert.True(t, IsRetryable(&APIError{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsRetryable(errors.New("unknown")))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := ParseRetryAfter("5", now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, wait)
+
+	wait, ok = ParseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, wait)
+
+	_, ok = ParseRetryAfter("soon", now)
+	assert.False(t, ok)
+	_, ok = ParseRetryAfter("", now)
+	assert.False(t, ok)
+}
@@ -0,0 +1,116 @@
+// Package batch collects the requests of reconciles running at the same time and sends them as one request.
+package batch
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+)
+
+// SendFn sends the items of a batch as one request
+type SendFn[T any] func(ctx context.Context, items []T) error
+
+// Batcher collects the items of concurrent reconciles and sends them as one request per key, e.g. per API client.
+// Items are identified by the id function, an item replaces a pending item with the same id.
+type Batcher[K comparable, I comparable, T any] struct {
+	mu      sync.Mutex
+	window  time.Duration
+	timeout time.Duration
+	id      func(T) I
+	compare func(a, b T) int
+	batches map[K]*batch[I, T]
+}
+
+type batch[I comparable, T any] struct {
+	items map[I]T
+	done  chan struct{}
+	errs  map[I]error
+}
+
+// New returns a Batcher collecting items for the given window. timeout limits the requests of a batch, it is independent
+// of the reconciles waiting for it. Items are sent sorted by compare to get stable requests.
+func New[K comparable, I comparable, T any](window, timeout time.Duration, id func(T) I, compare func(a, b T) int) *Batcher[K, I, T] {
+	return &Batcher[K, I, T]{window: window, timeout: timeout, id: id, compare: compare, batches: map[K]*batch[I, T]{}}
+}
+
+// Add adds the item to the pending batch of the key and waits until the batch has been sent.
+// The first item of a batch starts its window, the send function and the context values of that item are used to send the batch.
+// Related items are sent along, e.g. pending changes of other resources that are not reconciled at the same time. They don't
+// replace an item added by its own reconcile and their errors are left to it. The returned error only relates to the given item.
+func (b *Batcher[K, I, T]) Add(ctx context.Context, key K, send SendFn[T], item T, related ...T) error {
+	b.mu.Lock()
+	pending, ok := b.batches[key]
+	if !ok {
+		pending = &batch[I, T]{items: map[I]T{}, done: make(chan struct{})}
+		b.batches[key] = pending
+		// values like the logger and the ProviderConfig label of the metrics are taken over from the first reconcile
+		batchCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(b.window, func() { b.send(batchCtx, key, pending, send) })
+	}
+	for _, r := range related {
+		if _, ok := pending.items[b.id(r)]; !ok {
+			pending.items[b.id(r)] = r
+		}
+	}
+	pending.items[b.id(item)] = item
+	b.mu.Unlock()
+
+	select {
+	case <-pending.done:
+		return pending.errs[b.id(item)]
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Pending returns the number of batches that have not been sent yet
+func (b *Batcher[K, I, T]) Pending() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.batches)
+}
+
+// send sends the batch as one request. The APIs reject such a request as a whole, e.g. if a single item is invalid,
+// so in that case every item is sent on its own to report the error to the item it belongs to.
+// Transient errors and rate limiting are not caused by an item, they are reported to all items without sending them again.
+func (b *Batcher[K, I, T]) send(ctx context.Context, key K, pending *batch[I, T], send SendFn[T]) {
+	b.mu.Lock()
+	delete(b.batches, key)
+	items := make([]T, 0, len(pending.items))
+	for _, item := range pending.items {
+		items = append(items, item)
+	}
+	b.mu.Unlock()
+	slices.SortFunc(items, b.compare)
+
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+	errs := map[I]error{}
+	if err := send(ctx, items); err != nil {
+		for _, item := range items {
+			if len(items) == 1 || !resendable(ctx, err) {
+				errs[b.id(item)] = err
+			} else {
+				errs[b.id(item)] = send(ctx, []T{item})
+			}
+		}
+	}
+	pending.errs = errs
+	close(pending.done)
+}
+
+// resendable returns false if the failure of a request is not caused by its items, sending them one by one would fail as well.
+func resendable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch apierror.ClassOf(err) {
+	case apierror.Transient, apierror.RateLimited:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,193 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+)
+
+var errInvalid = errors.New("invalid item")
+
+// recordingSend records the requests, it fails each request containing the failing item with err
+type recordingSend struct {
+	mu       sync.Mutex
+	requests [][]string
+	failing  string
+	err      error
+}
+
+func (r *recordingSend) send(ctx context.Context, items []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, items)
+	for _, item := range items {
+		if item == r.failing {
+			return r.err
+		}
+	}
+	return nil
+}
+
+func identity(item string) string { return item }
+
+func addItems(b *Batcher[string, string, string], key string, send SendFn[string], items ...string) map[string]error {
+	var mu sync.Mutex
+	errs := map[string]error{}
+	var wg sync.WaitGroup
+	for _, item := range items {
+		wg.Add(1)
+		go func(item string) {
+			defer wg.Done()
+			err := b.Add(context.Background(), key, send, item)
+			mu.Lock()
+			errs[item] = err
+			mu.Unlock()
+		}(item)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestBatcher(t *testing.T) {
+	unavailable := &apierror.APIError{StatusCode: http.StatusServiceUnavailable}
+	rateLimited := &apierror.APIError{StatusCode: http.StatusTooManyRequests}
+	tests := map[string]struct {
+		reason       string
+		failing      string
+		err          error
+		wantRequests [][]string
+		wantErrs     map[string]error
+	}{
+		"Batched": {
+			reason:       "Items collected within the window are sent sorted as one request",
+			wantRequests: [][]string{{"a", "b", "c"}},
+			wantErrs:     map[string]error{"a": nil, "b": nil, "c": nil},
+		},
+		"Rejected": {
+			reason:  "A rejected request is resent per item to report the error to the item it belongs to",
+			failing: "b",
+			err:     errInvalid,
+			wantRequests: [][]string{
+				{"a", "b", "c"},
+				{"a"}, {"b"}, {"c"},
+			},
+			wantErrs: map[string]error{"a": nil, "b": errInvalid, "c": nil},
+		},
+		"Transient": {
+			reason:       "Server errors are reported to all items without resending them",
+			failing:      "b",
+			err:          unavailable,
+			wantRequests: [][]string{{"a", "b", "c"}},
+			wantErrs:     map[string]error{"a": unavailable, "b": unavailable, "c": unavailable},
+		},
+		"RateLimited": {
+			reason:       "Rate limited requests are reported to all items without resending them",
+			failing:      "b",
+			err:          rateLimited,
+			wantRequests: [][]string{{"a", "b", "c"}},
+			wantErrs:     map[string]error{"a": rateLimited, "b": rateLimited, "c": rateLimited},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := New[string](50*time.Millisecond, time.Minute, identity, strings.Compare)
+			api := &recordingSend{failing: tc.failing, err: tc.err}
+
+			errs := addItems(b, "key", api.send, "c", "a", "b")
+
+			if diff := cmp.Diff(tc.wantRequests, api.requests); diff != "" {
+				t.Errorf("\n%s\nAdd(...): -want requests, +got requests:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantErrs, errs, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAdd(...): -want errors, +got errors:\n%s\n", tc.reason, diff)
+			}
+			if b.Pending() != 0 {
+				t.Errorf("\n%s\nAdd(...): sent batches must be removed", tc.reason)
+			}
+		})
+	}
+}
+
+func TestBatcherPerKey(t *testing.T) {
+	b := New[string](50*time.Millisecond, time.Minute, identity, strings.Compare)
+	api := &recordingSend{}
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"sa-1", "sa-2"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			addItems(b, key, api.send, "a", "a")
+		}(key)
+	}
+	wg.Wait()
+
+	if diff := cmp.Diff([][]string{{"a"}, {"a"}}, api.requests); diff != "" {
+		t.Errorf("Add(...): want one request per key with duplicate items replaced, -want, +got:\n%s\n", diff)
+	}
+}
+
+type contextKey struct{}
+
+func TestBatcherContext(t *testing.T) {
+	b := New[string](50*time.Millisecond, time.Minute, identity, strings.Compare)
+	var got any
+	send := func(ctx context.Context, items []string) error {
+		got = ctx.Value(contextKey{})
+		return ctx.Err()
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), contextKey{}, "first"))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = b.Add(ctx, "key", send, "a")
+	}()
+	cancel()
+	<-done
+	if err := b.Add(context.Background(), "key", send, "b"); err != nil {
+		t.Errorf("Add(...): the batch must not be canceled with the reconcile that started it, got %v", err)
+	}
+	if got != "first" {
+		t.Errorf("Add(...): want the values of the first context, got %v", got)
+	}
+}
+
+func TestBatcherRelated(t *testing.T) {
+	b := New[string](50*time.Millisecond, time.Minute, func(item string) string { return item[:1] }, strings.Compare)
+	api := &recordingSend{failing: "c-related", err: errInvalid}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		errs[0] = b.Add(context.Background(), "key", api.send, "a", "b-related", "c-related")
+	}()
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+		errs[1] = b.Add(context.Background(), "key", api.send, "b-own", "a-related")
+	}()
+	wg.Wait()
+
+	want := [][]string{
+		{"a", "b-own", "c-related"},
+		{"a"}, {"b-own"}, {"c-related"},
+	}
+	if diff := cmp.Diff(want, api.requests); diff != "" {
+		t.Errorf("Add(...): related items must be sent along without replacing the items of their own reconcile, -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]error{nil, nil}, errs, test.EquateErrors()); diff != "" {
+		t.Errorf("Add(...): errors of related items must not be reported, -want, +got:\n%s\n", diff)
+	}
+}
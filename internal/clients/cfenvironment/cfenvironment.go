@@ -43,7 +43,7 @@ func (c CloudFoundryOrganization) UpdateInstance(ctx context.Context, cr v1alpha
 }
 
 func NewCloudFoundryOrganization(btp btp.Client) *CloudFoundryOrganization {
-	return &CloudFoundryOrganization{btp: btp}
+	return &CloudFoundryOrganization{btp: btp.WithEnvironmentLookup()}
 }
 
 func (c CloudFoundryOrganization) DescribeInstance(
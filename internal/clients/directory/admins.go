@@ -0,0 +1,115 @@
+package directory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
+)
+
+const (
+	featureAuthorizations        = "AUTHORIZATIONS"
+	directoryAdminRoleCollection = "Directory Administrator"
+)
+
+// AdminsAccessorFn connects to the xsuaa api of the directory, it returns nil if admins are not reconciled against the
+// role collection. It is only called once the directory exists, since the credentials usually are created for it.
+type AdminsAccessorFn func(ctx context.Context) (admins.Accessor, error)
+
+func (d *DirectoryClient) adminsAccessor(ctx context.Context) (admins.Accessor, error) {
+	if d.adminsFn == nil {
+		return nil, nil
+	}
+	return d.adminsFn(ctx)
+}
+
+// syncAdmins observes the users of the directory administrator role collection, as long as the directory manages
+// authorizations and credentials of its xsuaa api are given.
+func (d *DirectoryClient) syncAdmins(ctx context.Context) error {
+	d.cr.Status.AtProvider.DirectoryAdmins = nil
+	if !slices.Contains(d.cachedApi.DirectoryFeatures, featureAuthorizations) ||
+		internal.Val(d.cachedApi.EntityState) != v1alpha1.DirectoryEntityStateOk {
+		return nil
+	}
+	accessor, err := d.adminsAccessor(ctx)
+	if err != nil || accessor == nil {
+		return err
+	}
+	users, err := admins.List(ctx, accessor, directoryAdminRoleCollection)
+	if err != nil {
+		return err
+	}
+	d.cr.Status.AtProvider.DirectoryAdmins = &users
+	return nil
+}
+
+// updateAdmins assigns missing admins and revokes admins removed from the spec, once the role collection has been observed
+func (d *DirectoryClient) updateAdmins(ctx context.Context) error {
+	toAssign, toRevoke := adminsDiff(d.cr, d.technicalUsers())
+	if len(toAssign) == 0 && len(toRevoke) == 0 {
+		return nil
+	}
+	accessor, err := d.adminsAccessor(ctx)
+	if err != nil || accessor == nil {
+		return err
+	}
+	return admins.Apply(ctx, accessor, directoryAdminRoleCollection, toAssign, toRevoke)
+}
+
+func (d *DirectoryClient) technicalUsers() []string {
+	if d.btpClient == nil {
+		return nil
+	}
+	return admins.TechnicalUsers(d.btpClient.Credential)
+}
+
+// adminsChanged returns true if the admins need to be assigned, as long as the directory manages authorizations.
+// The admins are compared with the role collection if it has been observed, otherwise only with the last assignment,
+// since the accounts service does not return the admins of a directory.
+func adminsChanged(cr *v1alpha1.Directory, keep []string) bool {
+	if !slices.Contains(cr.Status.AtProvider.DirectoryFeatures, featureAuthorizations) {
+		return false
+	}
+	if cr.Status.AtProvider.DirectoryAdmins != nil {
+		toAssign, toRevoke := adminsDiff(cr, keep)
+		return len(toAssign) > 0 || len(toRevoke) > 0
+	}
+	return !sameUsers(cr.Spec.ForProvider.DirectoryAdmins, cr.Status.AtProvider.ManagedDirectoryAdmins)
+}
+
+// adminsDiff compares the desired admins with the users of the role collection. Only admins that have been assigned by
+// crossplane are revoked, the users to keep never are. Nothing is to be done as long as the role collection has not been observed.
+func adminsDiff(cr *v1alpha1.Directory, keep []string) (toAssign []string, toRevoke []string) {
+	obs := cr.Status.AtProvider
+	if obs.DirectoryAdmins == nil {
+		return nil, nil
+	}
+	return admins.Diff(cr.Spec.ForProvider.DirectoryAdmins, *obs.DirectoryAdmins, obs.ManagedDirectoryAdmins, keep)
+}
+
+// managedAdmins returns the admins assigned along with the directory features, admins only apply with authorizations enabled.
+func managedAdmins(params v1alpha1.DirectoryParameters) []string {
+	if !slices.Contains(params.DirectoryFeatures, featureAuthorizations) {
+		return nil
+	}
+	return append([]string(nil), params.DirectoryAdmins...)
+}
+
+// sameUsers compares usernames case-insensitive and independent of their order
+func sameUsers(a, b []string) bool {
+	return slices.Equal(lowerSorted(a), lowerSorted(b))
+}
+
+func lowerSorted(users []string) []string {
+	lower := make([]string, 0, len(users))
+	for _, user := range users {
+		if !slices.Contains(lower, strings.ToLower(user)) {
+			lower = append(lower, strings.ToLower(user))
+		}
+	}
+	slices.Sort(lower)
+	return lower
+}
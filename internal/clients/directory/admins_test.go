@@ -0,0 +1,228 @@
+package directory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/testutils"
+)
+
+func TestAdminsChanged(t *testing.T) {
+	withAuthorizations := []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"}
+	tests := map[string]struct {
+		reason   string
+		desired  []string
+		features []string
+		managed  []string
+		observed *[]string
+		want     bool
+	}{
+		"WithoutAuthorizations": {
+			reason:   "Admins only apply to directories managing authorizations",
+			desired:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			features: []string{"DEFAULT", "ENTITLEMENTS"},
+		},
+		"NeverAssigned": {
+			reason:   "Admins of directories not assigned by crossplane yet need to be assigned",
+			desired:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			features: withAuthorizations,
+			want:     true,
+		},
+		"Unchanged": {
+			reason:   "Admins are compared case-insensitive and independent of their order",
+			desired:  []string{"jane.doe@example.com", "John.Doe@example.com"},
+			features: withAuthorizations,
+			managed:  []string{"john.doe@example.com", "jane.doe@example.com"},
+		},
+		"Added": {
+			reason:   "Added admins need to be assigned",
+			desired:  []string{"jane.doe@example.com", "john.doe@example.com", "max.mustermann@example.com"},
+			features: withAuthorizations,
+			managed:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			want:     true,
+		},
+		"RemovedFromRoleCollection": {
+			reason:   "Admins removed from the role collection outside of crossplane need to be assigned again",
+			desired:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			features: withAuthorizations,
+			managed:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			observed: &[]string{"jane.doe@example.com"},
+			want:     true,
+		},
+		"UnmanagedInRoleCollection": {
+			reason:   "Admins assigned outside of crossplane are kept",
+			desired:  []string{"jane.doe@example.com"},
+			features: withAuthorizations,
+			managed:  []string{"jane.doe@example.com"},
+			observed: &[]string{"Jane.Doe@example.com", "max.mustermann@example.com"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := testutils.NewDirectory("unittest-client",
+				testutils.WithData(v1alpha1.DirectoryParameters{DirectoryAdmins: tc.desired}),
+				testutils.WithStatus(v1alpha1.DirectoryObservation{DirectoryFeatures: tc.features, ManagedDirectoryAdmins: tc.managed, DirectoryAdmins: tc.observed}))
+			if got := adminsChanged(cr, nil); got != tc.want {
+				t.Errorf("\n%s\nadminsChanged(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateDirectoryRecordsAdmins(t *testing.T) {
+	tests := map[string]struct {
+		features []string
+		want     []string
+	}{
+		"WithAuthorizations": {
+			features: []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"},
+			want:     []string{"jane.doe@example.com", "john.doe@example.com"},
+		},
+		"WithoutAuthorizations": {
+			features: []string{"DEFAULT"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			btpClient := btp.Client{AccountsServiceClient: &accountclient.APIClient{DirectoryOperationsAPI: MockDirClient{}}}
+			cr := testutils.NewDirectory("unittest-client",
+				testutils.WithData(v1alpha1.DirectoryParameters{DirectoryAdmins: []string{"jane.doe@example.com", "john.doe@example.com"}, DirectoryFeatures: tc.features}),
+				testutils.WithStatus(v1alpha1.DirectoryObservation{DirectoryFeatures: tc.features}),
+				testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"))
+
+			if _, err := NewDirectoryClient(&btpClient, cr).UpdateDirectory(context.Background()); err != nil {
+				t.Fatalf("UpdateDirectory(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, cr.Status.AtProvider.ManagedDirectoryAdmins); diff != "" {
+				t.Errorf("UpdateDirectory(...): -want admins, +got admins:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAdminsDiff(t *testing.T) {
+	type want struct {
+		toAssign []string
+		toRevoke []string
+	}
+	tests := map[string]struct {
+		reason   string
+		desired  []string
+		managed  []string
+		observed *[]string
+		keep     []string
+		want     want
+	}{
+		"NotObserved": {
+			reason:  "Nothing is to be done as long as the role collection has not been observed",
+			desired: []string{"jane.doe@example.com"},
+		},
+		"AssignMissing": {
+			reason:   "Desired admins missing in the role collection are assigned",
+			desired:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			managed:  []string{"jane.doe@example.com", "john.doe@example.com"},
+			observed: &[]string{"JANE.DOE@example.com"},
+			want:     want{toAssign: []string{"john.doe@example.com"}},
+		},
+		"RevokeManagedOnly": {
+			reason:   "Only admins assigned by crossplane are revoked, others are kept",
+			desired:  []string{"jane.doe@example.com"},
+			managed:  []string{"jane.doe@example.com", "john.doe@example.com", "max.mustermann@example.com"},
+			observed: &[]string{"jane.doe@example.com", "john.doe@example.com", "erika.mustermann@example.com"},
+			want:     want{toRevoke: []string{"john.doe@example.com"}},
+		},
+		"KeepTechnicalUser": {
+			reason:   "The user of the provider is never revoked",
+			desired:  []string{"jane.doe@example.com"},
+			managed:  []string{"jane.doe@example.com", "provider@example.com"},
+			observed: &[]string{"jane.doe@example.com", "Provider@example.com"},
+			keep:     []string{"provider@example.com"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := testutils.NewDirectory("unittest-client",
+				testutils.WithData(v1alpha1.DirectoryParameters{DirectoryAdmins: tc.desired}),
+				testutils.WithStatus(v1alpha1.DirectoryObservation{ManagedDirectoryAdmins: tc.managed, DirectoryAdmins: tc.observed}))
+			toAssign, toRevoke := adminsDiff(cr, tc.keep)
+			if diff := cmp.Diff(tc.want, want{toAssign: toAssign, toRevoke: toRevoke}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nadminsDiff(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type mockAdminsAccessor struct {
+	users    []string
+	assigned []string
+	revoked  []string
+}
+
+func (m *mockAdminsAccessor) ListUsers(ctx context.Context, origin, roleCollection string) ([]string, error) {
+	return m.users, nil
+}
+
+func (m *mockAdminsAccessor) AssignRole(ctx context.Context, origin, username, rolecollection string) error {
+	m.assigned = append(m.assigned, username)
+	return nil
+}
+
+func (m *mockAdminsAccessor) RevokeRole(ctx context.Context, origin, username, rolecollection string) error {
+	m.revoked = append(m.revoked, username)
+	return nil
+}
+
+func TestUpdateDirectoryAssignsAdmins(t *testing.T) {
+	features := []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"}
+	accessor := &mockAdminsAccessor{}
+	btpClient := btp.Client{AccountsServiceClient: &accountclient.APIClient{DirectoryOperationsAPI: MockDirClient{}}}
+	cr := testutils.NewDirectory("unittest-client",
+		testutils.WithData(v1alpha1.DirectoryParameters{DirectoryAdmins: []string{"jane.doe@example.com"}, DirectoryFeatures: features}),
+		testutils.WithStatus(v1alpha1.DirectoryObservation{
+			DirectoryFeatures:      features,
+			ManagedDirectoryAdmins: []string{"jane.doe@example.com", "john.doe@example.com"},
+			DirectoryAdmins:        &[]string{"john.doe@example.com", "max.mustermann@example.com"},
+		}),
+		testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"))
+
+	client := NewDirectoryClient(&btpClient, cr).WithAdmins(func(ctx context.Context) (admins.Accessor, error) {
+		return accessor, nil
+	})
+	if _, err := client.UpdateDirectory(context.Background()); err != nil {
+		t.Fatalf("UpdateDirectory(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"jane.doe@example.com"}, accessor.assigned); diff != "" {
+		t.Errorf("UpdateDirectory(...): -want assigned, +got assigned:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"john.doe@example.com"}, accessor.revoked); diff != "" {
+		t.Errorf("UpdateDirectory(...): -want revoked, +got revoked:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"jane.doe@example.com"}, cr.Status.AtProvider.ManagedDirectoryAdmins); diff != "" {
+		t.Errorf("UpdateDirectory(...): -want admins, +got admins:\n%s\n", diff)
+	}
+}
+
+func TestCreateDirectoryRecordsAdmins(t *testing.T) {
+	cr := testutils.NewDirectory("unittest-client", testutils.WithData(v1alpha1.DirectoryParameters{
+		DisplayName:       internal.Ptr("created-from-unittest"),
+		DirectoryFeatures: []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"},
+		DirectoryAdmins:   []string{"jane.doe@example.com"},
+	}))
+	btpClient := &btp.Client{AccountsServiceClient: &accountclient.APIClient{
+		DirectoryOperationsAPI: MockDirClient{CreateResult: &accountclient.DirectoryResponseObject{Guid: "aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"}},
+	}}
+
+	if _, err := NewDirectoryClient(btpClient, cr).CreateDirectory(context.Background()); err != nil {
+		t.Fatalf("CreateDirectory(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"jane.doe@example.com"}, cr.Status.AtProvider.ManagedDirectoryAdmins); diff != "" {
+		t.Errorf("CreateDirectory(...): -want admins, +got admins:\n%s\n", diff)
+	}
+}
@@ -3,7 +3,6 @@ package directory
 import (
 	"context"
 	"errors"
-	"fmt"
 	"reflect"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -11,6 +10,9 @@ import (
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/accountmetadata"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/jobs"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 )
 
@@ -37,16 +39,33 @@ func NewDirectoryClient(btpClient *btp.Client, cr *v1alpha1.Directory) *Director
 type DirectoryClient struct {
 	btpClient *btp.Client
 	cr        *v1alpha1.Directory
+	adminsFn  AdminsAccessorFn
 
 	cachedApi *accountclient.DirectoryResponseObject
 }
 
+// WithAdmins reconciles the directory admins against the role collection with the accessor returned by adminsFn
+func (d *DirectoryClient) WithAdmins(adminsFn AdminsAccessorFn) *DirectoryClient {
+	d.adminsFn = adminsFn
+	return d
+}
+
 func (d *DirectoryClient) UpdateDirectory(ctx context.Context) (*v1alpha1.Directory, error) {
 	// without an externalID we can't connect to the API
 	if d.externalID() == "" {
 		return d.cr, errors.New(errMisUse)
 	}
 
+	if featuresAdded(d.cr.Spec.ForProvider.DirectoryFeatures, d.cr.Status.AtProvider.DirectoryFeatures) {
+		path, err := d.ancestors(ctx, internal.Val(d.cr.Status.AtProvider.ParentGuid))
+		if err != nil {
+			return d.cr, err
+		}
+		if err := validateFeatures(d.cr.Spec.ForProvider.DirectoryFeatures, path); err != nil {
+			return d.cr, err
+		}
+	}
+
 	params := d.toUpdateApiPayload()
 
 	_, _, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
@@ -63,7 +82,45 @@ func (d *DirectoryClient) UpdateDirectory(ctx context.Context) (*v1alpha1.Direct
 		UpdateDirectoryTypeRequestPayload(d.toUpdateFeaturesApiPayload()).
 		Execute()
 
-	return d.cr, err
+	if err != nil {
+		return d.cr, err
+	}
+	if err := d.updateAdmins(ctx); err != nil {
+		return d.cr, err
+	}
+	d.cr.Status.AtProvider.ManagedDirectoryAdmins = managedAdmins(d.cr.Spec.ForProvider)
+
+	if err := d.updateSettings(ctx); err != nil {
+		return d.cr, err
+	}
+	return d.cr, d.updateEntitlements(ctx)
+}
+
+// updateSettings applies the managed entity settings, settings not listed in the spec are deleted
+func (d *DirectoryClient) updateSettings(ctx context.Context) error {
+	if d.cr.Status.AtProvider.EntitySettings == nil {
+		return nil
+	}
+	toUpdate, toDelete, err := accountmetadata.SettingsDiff(d.cr.Spec.ForProvider.EntitySettings, d.cr.Status.AtProvider.EntitySettings)
+	if err != nil {
+		return err
+	}
+	if len(toUpdate) > 0 {
+		_, _, err = d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
+			CreateOrUpdateDirectorySettings(ctx, d.externalID()).
+			EntitySettingsRequestPayload(accountclient.EntitySettingsRequestPayload{EntitySettings: toUpdate}).
+			Execute()
+		if err != nil {
+			return err
+		}
+	}
+	if len(toDelete) > 0 {
+		_, _, err = d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
+			DeleteDirectorySettings(ctx, d.externalID()).
+			Keys(toDelete).
+			Execute()
+	}
+	return err
 }
 
 func (d *DirectoryClient) DeleteDirectory(ctx context.Context) error {
@@ -93,14 +150,17 @@ func (d *DirectoryClient) getDirectory(ctx context.Context) (*accountclient.Dire
 	if extID == "" {
 		return nil, errors.New(errMisUse)
 	}
+	return d.getDirectoryByGuid(ctx, extID)
+}
 
-	directory, raw, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.GetDirectory(ctx, extID).Execute()
-	if raw.StatusCode == 404 {
+func (d *DirectoryClient) getDirectoryByGuid(ctx context.Context, guid string) (*accountclient.DirectoryResponseObject, error) {
+	directory, raw, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.GetDirectory(ctx, guid).Execute()
+	if raw != nil && raw.StatusCode == 404 {
 		// Unfortunately the API has no error type for 404 errors, so we can only extract that from raw status
 		return nil, nil
 	}
 	if err != nil {
-		return nil, specifyAPIError(err)
+		return nil, apierror.New(err, raw)
 	}
 	return directory, nil
 }
@@ -112,23 +172,45 @@ func (d *DirectoryClient) NeedsUpdate(ctx context.Context) (bool, error) {
 			return false, err
 		}
 	}
-	return !isSynced(d.cr, d.cachedApi), nil
+	if !isSynced(d.cr, d.cachedApi) || adminsChanged(d.cr, d.technicalUsers()) || entitlementsChanged(d.cr) {
+		return true, nil
+	}
+	return settingsChanged(d.cr)
 }
 
 func (d *DirectoryClient) CreateDirectory(ctx context.Context) (*v1alpha1.Directory, error) {
-	directory, _, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
+	if err := d.validateParent(ctx); err != nil {
+		return d.cr, err
+	}
+
+	directory, raw, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
 		CreateDirectory(ctx).
 		ParentGUID(d.cr.Spec.ForProvider.DirectoryGuid).
 		CreateDirectoryRequestPayload(d.toCreateApiPayload()).
 		Execute()
 
 	if err != nil {
-		return d.cr, specifyAPIError(err)
+		return d.cr, apierror.New(err, raw)
 	}
 	meta.SetExternalName(d.cr, directory.Guid)
+	d.cr.Status.AtProvider.Job = jobs.Start(jobs.OperationCreate, raw)
+	d.cr.Status.AtProvider.ManagedDirectoryAdmins = managedAdmins(d.cr.Spec.ForProvider)
 	return d.cr, nil
 }
 
+// validateParent checks nesting and features against the directories above the configured parent before creation
+func (d *DirectoryClient) validateParent(ctx context.Context) error {
+	parent := d.cr.Spec.ForProvider.DirectoryGuid
+	path, err := d.ancestors(ctx, parent)
+	if err != nil {
+		return err
+	}
+	if err := validateDepth(parent, path); err != nil {
+		return err
+	}
+	return validateFeatures(d.cr.Spec.ForProvider.DirectoryFeatures, path)
+}
+
 func (d *DirectoryClient) SyncStatus(ctx context.Context) error {
 	if d.cachedApi == nil {
 		var err error
@@ -143,12 +225,66 @@ func (d *DirectoryClient) SyncStatus(ctx context.Context) error {
 	d.cr.Status.AtProvider.StateMessage = d.cachedApi.StateMessage
 	d.cr.Status.AtProvider.Subdomain = d.cachedApi.Subdomain
 	d.cr.Status.AtProvider.DirectoryFeatures = d.cachedApi.DirectoryFeatures
+	d.cr.Status.AtProvider.CustomProperties = accountmetadata.CustomProperties(d.cachedApi.CustomProperties)
+	syncHierarchy(d.cr, d.cachedApi)
 
+	if err := d.syncJob(ctx); err != nil {
+		return err
+	}
+	if err := d.syncSettings(ctx); err != nil {
+		return err
+	}
+	if err := d.syncAdmins(ctx); err != nil {
+		return err
+	}
+	return d.syncEntitlements(ctx)
+}
+
+// syncJob polls the job of the directory creation and reports its progress as condition.
+// Jobs unknown to the API are no longer tracked.
+func (d *DirectoryClient) syncJob(ctx context.Context) error {
+	job := d.cr.Status.AtProvider.Job
+	if job == nil {
+		return nil
+	}
+	tracker := jobs.NewTracker(jobs.NewAccountsSource(d.btpClient.AccountsServiceClient.JobManagementAPI))
+	known, err := tracker.Poll(ctx, job)
+	if err != nil {
+		return err
+	}
+	if !known {
+		d.cr.Status.AtProvider.Job = nil
+		return nil
+	}
+	d.cr.SetConditions(v1alpha1.JobCondition(job))
+	return nil
+}
+
+// syncSettings observes the entity settings of the directory, as long as they are managed
+func (d *DirectoryClient) syncSettings(ctx context.Context) error {
+	if d.cr.Spec.ForProvider.EntitySettings == nil {
+		d.cr.Status.AtProvider.EntitySettings = nil
+		return nil
+	}
+	if internal.Val(d.cachedApi.EntityState) != v1alpha1.DirectoryEntityStateOk {
+		return nil
+	}
+	data, raw, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
+		GetDirectorySettings(ctx, d.externalID()).
+		Execute()
+	if err != nil {
+		return apierror.New(err, raw)
+	}
+	settings, err := accountmetadata.ObservedSettings(data)
+	if err != nil {
+		return err
+	}
+	d.cr.Status.AtProvider.EntitySettings = settings
 	return nil
 }
 
 func (d *DirectoryClient) IsAvailable() bool {
-	if d.cr.Status.AtProvider.EntityState == nil {
+	if d.cr.Status.AtProvider.EntityState == nil || jobs.InProgress(d.cr.Status.AtProvider.Job, jobs.OperationCreate) {
 		return false
 	}
 	return *d.cr.Status.AtProvider.EntityState == v1alpha1.DirectoryEntityStateOk
@@ -166,20 +302,36 @@ func (d *DirectoryClient) externalID() string {
 func isSynced(cr *v1alpha1.Directory, api *accountclient.DirectoryResponseObject) bool {
 	providedDirectoryFeatures := cr.Spec.ForProvider.DirectoryFeatures
 	if providedDirectoryFeatures == nil {
-		providedDirectoryFeatures = []string{"DEFAULT"}
+		providedDirectoryFeatures = []string{featureDefault}
 	}
 
 	return internal.Val(cr.Spec.ForProvider.Description) == internal.Val(api.Description) &&
 		internal.Val(cr.Spec.ForProvider.DisplayName) == api.DisplayName &&
-		reflect.DeepEqual(cr.Spec.ForProvider.Labels, internal.Val(api.Labels)) &&
-		reflect.DeepEqual(providedDirectoryFeatures, api.DirectoryFeatures)
+		reflect.DeepEqual(accountmetadata.LabelsWithCustomProperties(cr.Spec.ForProvider.Labels, cr.Spec.ForProvider.CustomProperties), internal.Val(api.Labels)) &&
+		sameFeatures(providedDirectoryFeatures, api.DirectoryFeatures)
+}
+
+// settingsChanged returns true if managed entity settings differ from the observed ones.
+// Nothing is changed as long as the settings have not been observed.
+func settingsChanged(cr *v1alpha1.Directory) (bool, error) {
+	if cr.Status.AtProvider.EntitySettings == nil {
+		return false, nil
+	}
+	toUpdate, toDelete, err := accountmetadata.SettingsDiff(cr.Spec.ForProvider.EntitySettings, cr.Status.AtProvider.EntitySettings)
+	return len(toUpdate) > 0 || len(toDelete) > 0, err
+}
+
+// labels returns the labels to send, custom properties are stored as labels with a single value
+func (d *DirectoryClient) labels() *map[string][]string {
+	labels := accountmetadata.LabelsWithCustomProperties(d.cr.Spec.ForProvider.Labels, d.cr.Spec.ForProvider.CustomProperties)
+	return &labels
 }
 
 func (d *DirectoryClient) toUpdateApiPayload() accountclient.UpdateDirectoryRequestPayload {
 	payload := accountclient.UpdateDirectoryRequestPayload{
 		Description: d.cr.Spec.ForProvider.Description,
 		DisplayName: d.cr.Spec.ForProvider.DisplayName,
-		Labels:      &d.cr.Spec.ForProvider.Labels,
+		Labels:      d.labels(),
 	}
 	return payload
 }
@@ -204,22 +356,10 @@ func (d *DirectoryClient) toCreateApiPayload() accountclient.CreateDirectoryRequ
 		DirectoryAdmins:   d.cr.Spec.ForProvider.DirectoryAdmins,
 		DirectoryFeatures: d.cr.Spec.ForProvider.DirectoryFeatures,
 		DisplayName:       displayName,
-		Labels:            &d.cr.Spec.ForProvider.Labels,
+		Labels:            d.labels(),
 		Subdomain:         d.cr.Spec.ForProvider.Subdomain,
 	}
 	return payload
 }
 
 var _ DirectoryClientI = &DirectoryClient{}
-
-func specifyAPIError(err error) error {
-	if genericErr, ok := err.(*accountclient.GenericOpenAPIError); ok {
-		if accountError, ok := genericErr.Model().(accountclient.ApiExceptionResponseObject); ok {
-			return fmt.Errorf("API Error: %v, Code %v", internal.Val(accountError.Error.Message), internal.Val(accountError.Error.Code))
-		}
-		if genericErr.Body() != nil {
-			return fmt.Errorf("API Error: %s", string(genericErr.Body()))
-		}
-	}
-	return err
-}
@@ -13,6 +13,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	"github.com/sap/crossplane-provider-btp/internal/testutils"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestNeedsCreation(t *testing.T) {
@@ -54,6 +55,20 @@ func TestNeedsCreation(t *testing.T) {
 					testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff")),
 			},
 		},
+		"NoResponse": {
+			reason: "Errors without a response should be returned",
+			args: args{
+				mockClient: MockDirClient{GetErr: errors.New("connection refused"), NoResponse: true},
+				cr: testutils.NewDirectory("unittest-client",
+					testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff")),
+			},
+			want: want{
+				o:   true,
+				err: errors.New("connection refused"),
+				cr: testutils.NewDirectory("unittest-client",
+					testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff")),
+			},
+		},
 		"NotExistingAnymore": {
 			reason: "In case of failing lookup we expect to require a creation",
 			args: args{
@@ -435,6 +450,37 @@ func TestSyncStatus(t *testing.T) {
 				})),
 			},
 		},
+		"SetCustomPropertiesAndSettings": {
+			reason: "Expect custom properties and managed entity settings in status",
+			args: args{
+				mockClient: MockDirClient{EntitySettingsResult: &accountclient.DataResponseObject{Values: []accountclient.PropertyDataResponseObject{
+					{Key: "owner", Value: map[string]interface{}{"name": "team-a"}},
+					{Key: "defaulted"},
+				}}},
+				cr: testutils.NewDirectory("unittest-client", testutils.WithData(v1alpha1.DirectoryParameters{
+					DisplayName:      internal.Ptr("created-from-unittest"),
+					CustomProperties: map[string]string{"costCenter": "4711"},
+					EntitySettings:   map[string]runtime.RawExtension{},
+				}), testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff")),
+				cachedApi: &accountclient.DirectoryResponseObject{
+					Guid:             "aaaaaaaa-bbbb-cccc-eeee-ffffffffffff",
+					EntityState:      internal.Ptr("OK"),
+					CustomProperties: []accountclient.PropertyResponseObject{{Key: "costCenter", Value: "4711"}},
+				},
+			},
+			want: want{
+				cr: testutils.NewDirectory("unittest-client", testutils.WithData(v1alpha1.DirectoryParameters{
+					DisplayName:      internal.Ptr("created-from-unittest"),
+					CustomProperties: map[string]string{"costCenter": "4711"},
+					EntitySettings:   map[string]runtime.RawExtension{},
+				}), testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"), testutils.WithStatus(v1alpha1.DirectoryObservation{
+					Guid:             internal.Ptr("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"),
+					EntityState:      internal.Ptr("OK"),
+					CustomProperties: map[string]string{"costCenter": "4711"},
+					EntitySettings:   map[string]runtime.RawExtension{"owner": {Raw: []byte(`{"name":"team-a"}`)}},
+				})),
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -863,3 +909,39 @@ func TestDirectoryPayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDirectoryTracksJob(t *testing.T) {
+	cr := testutils.NewDirectory("unittest-client", testutils.WithData(v1alpha1.DirectoryParameters{
+		DisplayName: internal.Ptr("created-from-unittest"),
+	}))
+	btpClient := &btp.Client{AccountsServiceClient: &accountclient.APIClient{
+		DirectoryOperationsAPI: MockDirClient{
+			CreateResult:      &accountclient.DirectoryResponseObject{Guid: "aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"},
+			CreateJobLocation: "/jobs-management/v1/jobs/job-1/status",
+		},
+		JobManagementAPI: MockJobClient{StatusCode: 200, StatusBody: `{"status":"FAILED","description":"subdomain already taken"}`},
+	}}
+
+	if _, err := NewDirectoryClient(btpClient, cr).CreateDirectory(context.Background()); err != nil {
+		t.Fatalf("CreateDirectory(...): %v", err)
+	}
+	job := cr.Status.AtProvider.Job
+	if job == nil || job.ID != "job-1" || job.Status != v1alpha1.JobStatusInProgress {
+		t.Fatalf("CreateDirectory(...): expected running job job-1, got %v", job)
+	}
+
+	client := NewDirectoryClient(btpClient, cr)
+	client.cachedApi = &accountclient.DirectoryResponseObject{Guid: "aaaaaaaa-bbbb-cccc-eeee-ffffffffffff", EntityState: internal.Ptr("CREATING")}
+	if err := client.SyncStatus(context.Background()); err != nil {
+		t.Fatalf("SyncStatus(...): %v", err)
+	}
+	if job.Status != v1alpha1.JobStatusFailed || job.Description != "subdomain already taken" {
+		t.Errorf("SyncStatus(...): expected failed job, got %v", job)
+	}
+	if cond := cr.GetCondition(v1alpha1.AsyncJobCondition); cond.Reason != v1alpha1.JobFailedReason {
+		t.Errorf("SyncStatus(...): expected condition reason %s, got %s", v1alpha1.JobFailedReason, cond.Reason)
+	}
+	if client.IsAvailable() {
+		t.Errorf("IsAvailable(...): directory of failed job must not be available")
+	}
+}
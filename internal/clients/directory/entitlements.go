@@ -0,0 +1,159 @@
+package directory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+const featureEntitlements = "ENTITLEMENTS"
+
+// syncEntitlements observes the assignments of the plans listed in the spec, as long as the directory manages entitlements
+func (d *DirectoryClient) syncEntitlements(ctx context.Context) error {
+	if d.cr.Spec.ForProvider.Entitlements == nil || !slices.Contains(d.cachedApi.DirectoryFeatures, featureEntitlements) {
+		d.cr.Status.AtProvider.Entitlements = nil
+		return nil
+	}
+	if internal.Val(d.cachedApi.EntityState) != v1alpha1.DirectoryEntityStateOk {
+		return nil
+	}
+	assignments, raw, err := d.btpClient.EntitlementsServiceClient.
+		GetDirectoryAssignments(ctx).
+		DirectoryGUID(d.externalID()).
+		Execute()
+	if err != nil {
+		return apierror.New(err, raw)
+	}
+	d.cr.Status.AtProvider.Entitlements = observedEntitlements(d.cr.Spec.ForProvider.Entitlements, assignments, d.externalID())
+	return nil
+}
+
+// updateEntitlements assigns all plans whose assignment differs from the spec in one request
+func (d *DirectoryClient) updateEntitlements(ctx context.Context) error {
+	toAssign := entitlementsToAssign(d.cr.Spec.ForProvider.Entitlements, d.cr.Status.AtProvider.Entitlements)
+	if len(toAssign) == 0 {
+		return nil
+	}
+	_, raw, err := d.btpClient.EntitlementsServiceClient.
+		CreateOrUpdateEntitlements(ctx, d.externalID()).
+		DirectoryAssignmentsRequestPayloadCollection(entclient.DirectoryAssignmentsRequestPayloadCollection{Entitlements: toAssign}).
+		Execute()
+	return apierror.New(err, raw)
+}
+
+// entitlementsChanged returns true if any listed plan is assigned differently. Nothing is changed as long as the assignments have not been observed.
+func entitlementsChanged(cr *v1alpha1.Directory) bool {
+	return len(entitlementsToAssign(cr.Spec.ForProvider.Entitlements, cr.Status.AtProvider.Entitlements)) > 0
+}
+
+// observedEntitlements returns the assignments of the listed plans to the directory itself, assignments to its subaccounts are skipped
+func observedEntitlements(desired []v1alpha1.DirectoryEntitlementAssignment, assignments *entclient.EntitledAndAssignedServicesResponseObject, guid string) []v1alpha1.DirectoryEntitlementAssignmentObservation {
+	observed := []v1alpha1.DirectoryEntitlementAssignmentObservation{}
+	for _, service := range assignments.AssignedServices {
+		for _, plan := range service.ServicePlans {
+			for i := range plan.AssignmentInfo {
+				info := plan.AssignmentInfo[i]
+				if internal.Val(info.EntityId) != guid {
+					continue
+				}
+				obs := v1alpha1.DirectoryEntitlementAssignmentObservation{
+					Service:              internal.Val(service.Name),
+					Plan:                 internal.Val(plan.Name),
+					PlanUniqueIdentifier: internal.Val(plan.UniqueIdentifier),
+					Assigned:             *entitlement.NewAssignable(&info),
+				}
+				if slices.ContainsFunc(desired, func(e v1alpha1.DirectoryEntitlementAssignment) bool { return samePlan(e, obs) }) {
+					observed = append(observed, obs)
+				}
+			}
+		}
+	}
+	return observed
+}
+
+// entitlementsToAssign returns the payloads of the listed plans whose observed assignment differs, nil as long as nothing was observed.
+// A plan listed without unique identifier is compared with each observed plan of its name, that is not listed with its identifier.
+func entitlementsToAssign(desired []v1alpha1.DirectoryEntitlementAssignment, observed []v1alpha1.DirectoryEntitlementAssignmentObservation) []entclient.DirectoryAssignmentsRequestPayload {
+	if observed == nil {
+		return nil
+	}
+	var toAssign []entclient.DirectoryAssignmentsRequestPayload
+	for _, e := range desired {
+		matched := false
+		for i := range observed {
+			if !samePlan(e, observed[i]) || (e.PlanUniqueIdentifier == "" && listedWithIdentifier(desired, observed[i])) {
+				continue
+			}
+			matched = true
+			if assignmentChanged(e, &observed[i].Assigned) {
+				plan := e
+				plan.PlanUniqueIdentifier = observed[i].PlanUniqueIdentifier
+				toAssign = append(toAssign, toAssignmentPayload(plan))
+			}
+		}
+		if !matched && assignmentChanged(e, nil) {
+			toAssign = append(toAssign, toAssignmentPayload(e))
+		}
+	}
+	return toAssign
+}
+
+// assignmentChanged compares the plan with its assignment, distribute is not observable and never causes a change on its own.
+// Assignments in progress are not changed, they report the amount before the change until they are done.
+func assignmentChanged(e v1alpha1.DirectoryEntitlementAssignment, assigned *v1alpha1.Assignable) bool {
+	if assigned == nil {
+		return internal.Val(e.Amount) > 0 || internal.Val(e.Enable)
+	}
+	if assigned.EntityState == v1alpha1.EntitlementStatusStarted || assigned.EntityState == v1alpha1.EntitlementStatusProcessing {
+		return false
+	}
+	if e.Enable != nil && !*e.Enable {
+		return true
+	}
+	if e.Amount != nil && *e.Amount != internal.Val(assigned.Amount) {
+		return true
+	}
+	if e.AutoDistributeAmount != nil && int32(*e.AutoDistributeAmount) != assigned.AutoDistributeAmount {
+		return true
+	}
+	return e.AutoAssign != assigned.AutoAssign
+}
+
+func samePlan(e v1alpha1.DirectoryEntitlementAssignment, obs v1alpha1.DirectoryEntitlementAssignmentObservation) bool {
+	if e.Service != obs.Service || e.Plan != obs.Plan {
+		return false
+	}
+	return e.PlanUniqueIdentifier == "" || e.PlanUniqueIdentifier == obs.PlanUniqueIdentifier
+}
+
+// listedWithIdentifier returns true if the observed plan is listed with its unique identifier
+func listedWithIdentifier(desired []v1alpha1.DirectoryEntitlementAssignment, obs v1alpha1.DirectoryEntitlementAssignmentObservation) bool {
+	return slices.ContainsFunc(desired, func(e v1alpha1.DirectoryEntitlementAssignment) bool {
+		return e.PlanUniqueIdentifier != "" && samePlan(e, obs)
+	})
+}
+
+func toAssignmentPayload(e v1alpha1.DirectoryEntitlementAssignment) entclient.DirectoryAssignmentsRequestPayload {
+	payload := entclient.DirectoryAssignmentsRequestPayload{
+		Service:    e.Service,
+		Plan:       e.Plan,
+		Enable:     e.Enable,
+		AutoAssign: internal.Ptr(e.AutoAssign),
+		Distribute: internal.Ptr(e.Distribute),
+	}
+	if e.PlanUniqueIdentifier != "" {
+		payload.PlanUniqueIdentifier = internal.Ptr(e.PlanUniqueIdentifier)
+	}
+	if e.Amount != nil {
+		payload.Amount = internal.Ptr(float32(*e.Amount))
+	}
+	if e.AutoDistributeAmount != nil {
+		payload.AutoDistributeAmount = internal.Ptr(int32(*e.AutoDistributeAmount))
+	}
+	return payload
+}
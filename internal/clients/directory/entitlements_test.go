@@ -0,0 +1,183 @@
+package directory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+const directoryGuid = "aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"
+
+func TestObservedEntitlements(t *testing.T) {
+	assignments := &entclient.EntitledAndAssignedServicesResponseObject{
+		AssignedServices: []entclient.AssignedServiceResponseObject{
+			{
+				Name: internal.Ptr("postgresql-db"),
+				ServicePlans: []entclient.AssignedServicePlanResponseObject{
+					{
+						Name: internal.Ptr("development"),
+						AssignmentInfo: []entclient.AssignedServicePlanSubaccountDTO{
+							{EntityId: internal.Ptr(directoryGuid), Amount: internal.Ptr(float32(4)), AutoAssign: internal.Ptr(true), AutoDistributeAmount: internal.Ptr(int32(1))},
+							{EntityId: internal.Ptr("subaccount-in-directory"), Amount: internal.Ptr(float32(1))},
+						},
+					},
+					{
+						Name: internal.Ptr("premium"),
+						AssignmentInfo: []entclient.AssignedServicePlanSubaccountDTO{
+							{EntityId: internal.Ptr(directoryGuid), Amount: internal.Ptr(float32(2))},
+						},
+					},
+				},
+			},
+		},
+	}
+	desired := []v1alpha1.DirectoryEntitlementAssignment{{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(4)}}
+
+	want := []v1alpha1.DirectoryEntitlementAssignmentObservation{
+		{
+			Service: "postgresql-db",
+			Plan:    "development",
+			Assigned: v1alpha1.Assignable{
+				Amount:               internal.Ptr(4),
+				AutoAssign:           true,
+				AutoDistributeAmount: 1,
+				EntityID:             directoryGuid,
+			},
+		},
+	}
+	got := observedEntitlements(desired, assignments, directoryGuid)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(v1alpha1.Assignable{}, "Resources")); diff != "" {
+		t.Errorf("observedEntitlements(...): only assignments of listed plans to the directory itself are observed, -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestEntitlementsToAssign(t *testing.T) {
+	observed := []v1alpha1.DirectoryEntitlementAssignmentObservation{
+		{Service: "postgresql-db", Plan: "development", Assigned: v1alpha1.Assignable{Amount: internal.Ptr(4), AutoAssign: true, AutoDistributeAmount: 1}},
+		{Service: "auditlog-viewer", Plan: "free", PlanUniqueIdentifier: "auditlog-viewer-free", Assigned: v1alpha1.Assignable{}},
+	}
+	tests := map[string]struct {
+		reason   string
+		desired  v1alpha1.DirectoryEntitlementAssignment
+		observed []v1alpha1.DirectoryEntitlementAssignmentObservation
+		want     bool
+	}{
+		"NotObserved": {
+			reason:  "Nothing is assigned as long as the assignments have not been observed",
+			desired: v1alpha1.DirectoryEntitlementAssignment{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(5)},
+		},
+		"InSync": {
+			reason:   "Assignments matching the spec need no update",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(4), AutoAssign: true, AutoDistributeAmount: internal.Ptr(1)},
+			observed: observed,
+		},
+		"Distribute": {
+			reason:   "Distribute can't be observed and does not cause an update on its own",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(4), AutoAssign: true, Distribute: true},
+			observed: observed,
+		},
+		"Amount": {
+			reason:   "A changed amount is assigned",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(6), AutoAssign: true},
+			observed: observed,
+			want:     true,
+		},
+		"AutoDistributeAmount": {
+			reason:   "A changed amount to distribute to subaccounts is assigned",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(4), AutoAssign: true, AutoDistributeAmount: internal.Ptr(2)},
+			observed: observed,
+			want:     true,
+		},
+		"AutoAssign": {
+			reason:   "Disabling auto assignment is assigned",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "postgresql-db", Plan: "development", Amount: internal.Ptr(4)},
+			observed: observed,
+			want:     true,
+		},
+		"NotAssigned": {
+			reason:   "Plans not assigned yet are assigned",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "hana-cloud", Plan: "hana", Amount: internal.Ptr(1)},
+			observed: observed,
+			want:     true,
+		},
+		"RemovedNotAssigned": {
+			reason:   "Plans that are neither assigned nor desired need no update",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "hana-cloud", Plan: "hana", Amount: internal.Ptr(0)},
+			observed: observed,
+		},
+		"InProgress": {
+			reason:   "Assignments in progress are not changed again",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "hana-cloud", Plan: "hana", Amount: internal.Ptr(2)},
+			observed: []v1alpha1.DirectoryEntitlementAssignmentObservation{{Service: "hana-cloud", Plan: "hana", Assigned: v1alpha1.Assignable{Amount: internal.Ptr(1), RequestedAmount: 2, EntityState: v1alpha1.EntitlementStatusProcessing}}},
+		},
+		"Disabled": {
+			reason:   "Disabling an assigned plan removes its assignment",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "auditlog-viewer", Plan: "free", Enable: internal.Ptr(false)},
+			observed: observed,
+			want:     true,
+		},
+		"OtherUniqueIdentifier": {
+			reason:   "Plans of the same name with another unique identifier are assigned separately",
+			desired:  v1alpha1.DirectoryEntitlementAssignment{Service: "auditlog-viewer", Plan: "free", PlanUniqueIdentifier: "auditlog-viewer-free-eu10", Enable: internal.Ptr(true)},
+			observed: observed,
+			want:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := entitlementsToAssign([]v1alpha1.DirectoryEntitlementAssignment{tc.desired}, tc.observed)
+			if (len(got) > 0) != tc.want {
+				t.Errorf("\n%s\nentitlementsToAssign(...): want assignment %t, got %v", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEntitlementsToAssignPerUniqueIdentifier(t *testing.T) {
+	observed := []v1alpha1.DirectoryEntitlementAssignmentObservation{
+		{Service: "hana-cloud", Plan: "hana", PlanUniqueIdentifier: "hana-eu10", Assigned: v1alpha1.Assignable{Amount: internal.Ptr(1)}},
+		{Service: "hana-cloud", Plan: "hana", PlanUniqueIdentifier: "hana-us10", Assigned: v1alpha1.Assignable{Amount: internal.Ptr(1)}},
+		{Service: "hana-cloud", Plan: "hana", PlanUniqueIdentifier: "hana-ap10", Assigned: v1alpha1.Assignable{Amount: internal.Ptr(2)}},
+	}
+	desired := []v1alpha1.DirectoryEntitlementAssignment{
+		{Service: "hana-cloud", Plan: "hana", Amount: internal.Ptr(2)},
+		{Service: "hana-cloud", Plan: "hana", PlanUniqueIdentifier: "hana-us10", Amount: internal.Ptr(3)},
+	}
+
+	var got []string
+	for _, payload := range entitlementsToAssign(desired, observed) {
+		got = append(got, fmt.Sprintf("%s=%v", internal.Val(payload.PlanUniqueIdentifier), internal.Val(payload.Amount)))
+	}
+
+	if diff := cmp.Diff([]string{"hana-eu10=2", "hana-us10=3"}, got); diff != "" {
+		t.Errorf("entitlementsToAssign(...): each observed plan of the name is compared, plans listed with their identifier only with that entry, -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestToAssignmentPayload(t *testing.T) {
+	got := toAssignmentPayload(v1alpha1.DirectoryEntitlementAssignment{
+		Service:              "postgresql-db",
+		Plan:                 "development",
+		Amount:               internal.Ptr(4),
+		Distribute:           true,
+		AutoAssign:           true,
+		AutoDistributeAmount: internal.Ptr(1),
+	})
+	want := entclient.DirectoryAssignmentsRequestPayload{
+		Service:              "postgresql-db",
+		Plan:                 "development",
+		Amount:               internal.Ptr(float32(4)),
+		Distribute:           internal.Ptr(true),
+		AutoAssign:           internal.Ptr(true),
+		AutoDistributeAmount: internal.Ptr(int32(1)),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("toAssignmentPayload(...): -want, +got:\n%s\n", diff)
+	}
+}
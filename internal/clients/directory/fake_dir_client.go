@@ -2,7 +2,9 @@ package directory
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-openapi/runtime"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
@@ -11,16 +13,24 @@ import (
 type MockDirClient struct {
 	GetResult *accountclient.DirectoryResponseObject
 	GetErr    error
+	// Directories are looked up by GUID if set, instead of returning GetResult
+	Directories map[string]*accountclient.DirectoryResponseObject
 
-	CreateResult *accountclient.DirectoryResponseObject
-	CreateErr    error
+	CreateResult      *accountclient.DirectoryResponseObject
+	CreateErr         error
+	CreateJobLocation string
 
 	UpdateErr         error
 	UpdateSettingsErr error
 
+	EntitySettingsResult *accountclient.DataResponseObject
+	EntitySettingsErr    error
+
 	DeleteErr error
 
 	ResultStatusCode int
+	// NoResponse simulates requests failing before a response is received, e.g. due to a network error
+	NoResponse bool
 }
 
 var _ accountclient.DirectoryOperationsAPI = MockDirClient{}
@@ -30,7 +40,12 @@ func (m MockDirClient) CreateDirectory(ctx context.Context) accountclient.ApiCre
 }
 
 func (m MockDirClient) CreateDirectoryExecute(r accountclient.ApiCreateDirectoryRequest) (*accountclient.DirectoryResponseObject, *http.Response, error) {
-	return m.CreateResult, nil, m.CreateErr
+	if m.CreateJobLocation == "" {
+		return m.CreateResult, nil, m.CreateErr
+	}
+	raw := &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}}
+	raw.Header.Set("Location", m.CreateJobLocation)
+	return m.CreateResult, raw, m.CreateErr
 }
 
 func (m MockDirClient) DeleteDirectory(ctx context.Context, directoryGUID string) accountclient.ApiDeleteDirectoryRequest {
@@ -42,10 +57,19 @@ func (m MockDirClient) DeleteDirectoryExecute(r accountclient.ApiDeleteDirectory
 }
 
 func (m MockDirClient) GetDirectory(ctx context.Context, directoryGUID string) accountclient.ApiGetDirectoryRequest {
+	if m.Directories != nil {
+		m.GetResult = m.Directories[directoryGUID]
+		if m.GetResult == nil {
+			m.ResultStatusCode = http.StatusNotFound
+		}
+	}
 	return accountclient.ApiGetDirectoryRequest{ApiService: m}
 }
 
 func (m MockDirClient) GetDirectoryExecute(r accountclient.ApiGetDirectoryRequest) (*accountclient.DirectoryResponseObject, *http.Response, error) {
+	if m.NoResponse {
+		return nil, nil, m.GetErr
+	}
 	return m.GetResult, &http.Response{StatusCode: m.ResultStatusCode}, m.GetErr
 }
 
@@ -76,13 +100,11 @@ func (m MockDirClient) CreateDirectoryLabelsExecute(r accountclient.ApiCreateDir
 }
 
 func (m MockDirClient) CreateOrUpdateDirectorySettings(ctx context.Context, directoryGUID string) accountclient.ApiCreateOrUpdateDirectorySettingsRequest {
-	//TODO implement me
-	panic("implement me")
+	return accountclient.ApiCreateOrUpdateDirectorySettingsRequest{ApiService: m}
 }
 
 func (m MockDirClient) CreateOrUpdateDirectorySettingsExecute(r accountclient.ApiCreateOrUpdateDirectorySettingsRequest) (*accountclient.DataResponseObject, *http.Response, error) {
-	//TODO implement me
-	panic("implement me")
+	return m.EntitySettingsResult, nil, m.EntitySettingsErr
 }
 
 func (m MockDirClient) DeleteDirectoryLabels(ctx context.Context, directoryGUID string) accountclient.ApiDeleteDirectoryLabelsRequest {
@@ -96,13 +118,11 @@ func (m MockDirClient) DeleteDirectoryLabelsExecute(r accountclient.ApiDeleteDir
 }
 
 func (m MockDirClient) DeleteDirectorySettings(ctx context.Context, directoryGUID string) accountclient.ApiDeleteDirectorySettingsRequest {
-	//TODO implement me
-	panic("implement me")
+	return accountclient.ApiDeleteDirectorySettingsRequest{ApiService: m}
 }
 
 func (m MockDirClient) DeleteDirectorySettingsExecute(r accountclient.ApiDeleteDirectorySettingsRequest) (*accountclient.DataResponseObject, *http.Response, error) {
-	//TODO implement me
-	panic("implement me")
+	return m.EntitySettingsResult, nil, m.EntitySettingsErr
 }
 
 func (m MockDirClient) GetDirectoryCustomProperties(ctx context.Context, directoryGUID string) accountclient.ApiGetDirectoryCustomPropertiesRequest {
@@ -126,16 +146,30 @@ func (m MockDirClient) GetDirectoryLabelsExecute(r accountclient.ApiGetDirectory
 }
 
 func (m MockDirClient) GetDirectorySettings(ctx context.Context, directoryGUID string) accountclient.ApiGetDirectorySettingsRequest {
-	//TODO implement me
-	panic("implement me")
+	return accountclient.ApiGetDirectorySettingsRequest{ApiService: m}
 }
 
 func (m MockDirClient) GetDirectorySettingsExecute(r accountclient.ApiGetDirectorySettingsRequest) (*accountclient.DataResponseObject, *http.Response, error) {
-	//TODO implement me
-	panic("implement me")
+	return m.EntitySettingsResult, nil, m.EntitySettingsErr
 }
 
 func (m MockDirClient) SetTransport(transport runtime.ClientTransport) {
 	//TODO implement me
 	panic("implement me")
 }
+
+// MockJobClient returns the same raw status body for all jobs
+type MockJobClient struct {
+	StatusBody string
+	StatusCode int
+}
+
+var _ accountclient.JobManagementAPI = MockJobClient{}
+
+func (m MockJobClient) GetStatus(ctx context.Context, jobInstanceIdOrUniqueId string) accountclient.ApiGetStatusRequest {
+	return accountclient.ApiGetStatusRequest{ApiService: m}
+}
+
+func (m MockJobClient) GetStatusExecute(r accountclient.ApiGetStatusRequest) (string, *http.Response, error) {
+	return "", &http.Response{StatusCode: m.StatusCode, Body: io.NopCloser(strings.NewReader(m.StatusBody))}, nil
+}
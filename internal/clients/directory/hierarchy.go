@@ -0,0 +1,122 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+const (
+	// MaxDirectoryLevels is the number of directory levels BTP allows below the global account
+	MaxDirectoryLevels = 5
+
+	featureDefault = "DEFAULT"
+
+	errTooDeep          = "directory can't be created in %s, BTP allows at most %d directory levels"
+	errFeaturesInPath   = "directory %s above already enables %v, only one directory in a path can manage entitlements or authorizations"
+	errParentNotFound   = "parent directory %s not found"
+	msgMoveNotSupported = "directory is located in %s but configured for %s, the accounts service does not support moving directories; recreate the directory in the new parent instead"
+	globalAccountTitle  = "the global account"
+)
+
+// ancestors returns the directories above a directory located in parent, starting with the parent itself.
+// Walking up stops once MaxDirectoryLevels are found, as that already rules out further nesting.
+func (d *DirectoryClient) ancestors(ctx context.Context, parent string) ([]accountclient.DirectoryResponseObject, error) {
+	var path []accountclient.DirectoryResponseObject
+	for guid := parent; guid != "" && len(path) < MaxDirectoryLevels; {
+		dir, err := d.getDirectoryByGuid(ctx, guid)
+		if err != nil {
+			return nil, err
+		}
+		if dir == nil {
+			if guid == parent {
+				return nil, fmt.Errorf(errParentNotFound, guid)
+			}
+			break
+		}
+		path = append(path, *dir)
+		if dir.ParentGUID == dir.GlobalAccountGUID {
+			break
+		}
+		guid = dir.ParentGUID
+	}
+	return path, nil
+}
+
+// validateDepth rejects creating a directory in a path that already has the maximum number of levels.
+func validateDepth(parent string, path []accountclient.DirectoryResponseObject) error {
+	if len(path) >= MaxDirectoryLevels {
+		return fmt.Errorf(errTooDeep, parent, MaxDirectoryLevels)
+	}
+	return nil
+}
+
+// validateFeatures rejects enabling entitlement or authorization management below a directory that already manages them.
+func validateFeatures(features []string, path []accountclient.DirectoryResponseObject) error {
+	if !managesFeatures(features) {
+		return nil
+	}
+	for _, dir := range path {
+		if managesFeatures(dir.DirectoryFeatures) {
+			return fmt.Errorf(errFeaturesInPath, dir.Guid, dir.DirectoryFeatures)
+		}
+	}
+	return nil
+}
+
+// managesFeatures returns true if any feature beyond DEFAULT is enabled
+func managesFeatures(features []string) bool {
+	for _, f := range features {
+		if f != featureDefault {
+			return true
+		}
+	}
+	return false
+}
+
+// featuresAdded returns true if the desired features enable anything that is not enabled yet.
+func featuresAdded(desired, observed []string) bool {
+	for _, f := range desired {
+		if !slices.Contains(observed, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameFeatures compares features independent of their order, the API does not guarantee one.
+func sameFeatures(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
+// syncHierarchy observes the parent of the directory and reports if it differs from the configured one.
+// The condition is only added once the parent differs, to not clutter the status of directories that never change parents.
+func syncHierarchy(cr *v1alpha1.Directory, api *accountclient.DirectoryResponseObject) {
+	cr.Status.AtProvider.ParentGuid = nil
+	if api.ParentGUID != "" && api.ParentGUID != api.GlobalAccountGUID {
+		cr.Status.AtProvider.ParentGuid = internal.Ptr(api.ParentGUID)
+	}
+
+	desired := cr.Spec.ForProvider.DirectoryGuid
+	if desired == "" {
+		desired = api.GlobalAccountGUID
+	}
+	if api.ParentGUID != "" && desired != api.ParentGUID {
+		cr.SetConditions(v1alpha1.ParentChanged(fmt.Sprintf(msgMoveNotSupported, parentTitle(api.ParentGUID, api), parentTitle(desired, api))))
+		return
+	}
+	if cr.GetCondition(v1alpha1.HierarchyCondition).Reason == v1alpha1.MoveNotSupportedReason {
+		cr.SetConditions(v1alpha1.HierarchyInSync())
+	}
+}
+
+func parentTitle(guid string, api *accountclient.DirectoryResponseObject) string {
+	if guid == api.GlobalAccountGUID {
+		return globalAccountTitle
+	}
+	return guid
+}
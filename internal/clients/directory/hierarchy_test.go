@@ -0,0 +1,203 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/testutils"
+)
+
+const globalAccount = "global-account"
+
+// nestedDirectories returns a chain of directories dir-1 to dir-<levels>, dir-1 is located in the global account
+func nestedDirectories(levels int, features map[string][]string) map[string]*accountclient.DirectoryResponseObject {
+	dirs := map[string]*accountclient.DirectoryResponseObject{}
+	parent := globalAccount
+	for i := 1; i <= levels; i++ {
+		guid := fmt.Sprintf("dir-%d", i)
+		dirFeatures, ok := features[guid]
+		if !ok {
+			dirFeatures = []string{featureDefault}
+		}
+		dirs[guid] = &accountclient.DirectoryResponseObject{
+			Guid:              guid,
+			ParentGUID:        parent,
+			GlobalAccountGUID: globalAccount,
+			DirectoryFeatures: dirFeatures,
+		}
+		parent = guid
+	}
+	return dirs
+}
+
+func TestCreateDirectoryValidatesParent(t *testing.T) {
+	tests := map[string]struct {
+		reason      string
+		directories map[string]*accountclient.DirectoryResponseObject
+		params      v1alpha1.DirectoryParameters
+		wantErr     error
+	}{
+		"GlobalAccount": {
+			reason: "Directories in the global account need no lookup of parents",
+			params: v1alpha1.DirectoryParameters{DirectoryFeatures: []string{"DEFAULT", "ENTITLEMENTS"}},
+		},
+		"Nested": {
+			reason:      "Directories may be created up to the maximum level",
+			directories: nestedDirectories(4, nil),
+			params:      v1alpha1.DirectoryParameters{DirectoryGuid: "dir-4"},
+		},
+		"TooDeep": {
+			reason:      "Creating a directory below the maximum level must be rejected before calling the API",
+			directories: nestedDirectories(5, nil),
+			params:      v1alpha1.DirectoryParameters{DirectoryGuid: "dir-5"},
+			wantErr:     fmt.Errorf(errTooDeep, "dir-5", MaxDirectoryLevels),
+		},
+		"FeaturesInPath": {
+			reason:      "Only one directory in a path may manage entitlements",
+			directories: nestedDirectories(3, map[string][]string{"dir-2": {"DEFAULT", "ENTITLEMENTS"}}),
+			params:      v1alpha1.DirectoryParameters{DirectoryGuid: "dir-3", DirectoryFeatures: []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"}},
+			wantErr:     fmt.Errorf(errFeaturesInPath, "dir-2", []string{"DEFAULT", "ENTITLEMENTS"}),
+		},
+		"DefaultFeaturesInPath": {
+			reason:      "Directories with default features can be nested below directories managing entitlements",
+			directories: nestedDirectories(3, map[string][]string{"dir-2": {"DEFAULT", "ENTITLEMENTS"}}),
+			params:      v1alpha1.DirectoryParameters{DirectoryGuid: "dir-3", DirectoryFeatures: []string{"DEFAULT"}},
+		},
+		"ParentNotFound": {
+			reason:      "A parent that does not exist can't be validated",
+			directories: nestedDirectories(1, nil),
+			params:      v1alpha1.DirectoryParameters{DirectoryGuid: "dir-9"},
+			wantErr:     fmt.Errorf(errParentNotFound, "dir-9"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mock := MockDirClient{
+				Directories:  tc.directories,
+				CreateResult: &accountclient.DirectoryResponseObject{Guid: "123"},
+			}
+			btpClient := btp.Client{AccountsServiceClient: &accountclient.APIClient{DirectoryOperationsAPI: mock}}
+			cr := testutils.NewDirectory("unittest-client", testutils.WithData(tc.params))
+
+			_, err := NewDirectoryClient(&btpClient, cr).CreateDirectory(context.Background())
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.CreateDirectory(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateDirectoryValidatesFeatures(t *testing.T) {
+	tests := map[string]struct {
+		reason   string
+		observed []string
+		desired  []string
+		wantErr  error
+	}{
+		"EnableEntitlements": {
+			reason:   "Enabling entitlements below a directory that manages them must be rejected",
+			observed: []string{"DEFAULT"},
+			desired:  []string{"DEFAULT", "ENTITLEMENTS"},
+			wantErr:  fmt.Errorf(errFeaturesInPath, "dir-1", []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"}),
+		},
+		"RemoveAuthorizations": {
+			reason:   "Removing features needs no validation of the path",
+			observed: []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"},
+			desired:  []string{"DEFAULT", "ENTITLEMENTS"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mock := MockDirClient{Directories: nestedDirectories(1, map[string][]string{"dir-1": {"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"}})}
+			btpClient := btp.Client{AccountsServiceClient: &accountclient.APIClient{DirectoryOperationsAPI: mock}}
+			cr := testutils.NewDirectory("unittest-client",
+				testutils.WithData(v1alpha1.DirectoryParameters{DirectoryGuid: "dir-1", DirectoryFeatures: tc.desired}),
+				testutils.WithStatus(v1alpha1.DirectoryObservation{ParentGuid: internal.Ptr("dir-1"), DirectoryFeatures: tc.observed}),
+				testutils.WithExternalName("aaaaaaaa-bbbb-cccc-eeee-ffffffffffff"))
+
+			_, err := NewDirectoryClient(&btpClient, cr).UpdateDirectory(context.Background())
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.UpdateDirectory(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSyncHierarchy(t *testing.T) {
+	tests := map[string]struct {
+		reason         string
+		parent         string
+		configured     string
+		priorCondition bool
+		wantParent     *string
+		wantCondition  corev1.ConditionStatus
+	}{
+		"GlobalAccount": {
+			reason:        "Directories in the global account have no parent directory and no condition",
+			parent:        globalAccount,
+			wantCondition: corev1.ConditionUnknown,
+		},
+		"Nested": {
+			reason:        "The parent directory is observed",
+			parent:        "dir-1",
+			configured:    "dir-1",
+			wantParent:    internal.Ptr("dir-1"),
+			wantCondition: corev1.ConditionUnknown,
+		},
+		"Moved": {
+			reason:        "A changed parent is reported as the API can't move directories",
+			parent:        "dir-1",
+			configured:    "dir-2",
+			wantParent:    internal.Ptr("dir-1"),
+			wantCondition: corev1.ConditionFalse,
+		},
+		"MovedToGlobalAccount": {
+			reason:        "Removing the parent is a move to the global account",
+			parent:        "dir-1",
+			wantParent:    internal.Ptr("dir-1"),
+			wantCondition: corev1.ConditionFalse,
+		},
+		"Reverted": {
+			reason:         "The condition recovers once the configured parent is restored",
+			parent:         "dir-1",
+			configured:     "dir-1",
+			priorCondition: true,
+			wantParent:     internal.Ptr("dir-1"),
+			wantCondition:  corev1.ConditionTrue,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := testutils.NewDirectory("unittest-client", testutils.WithData(v1alpha1.DirectoryParameters{DirectoryGuid: tc.configured}))
+			if tc.priorCondition {
+				cr.SetConditions(v1alpha1.ParentChanged("moved"))
+			}
+			syncHierarchy(cr, &accountclient.DirectoryResponseObject{ParentGUID: tc.parent, GlobalAccountGUID: globalAccount})
+
+			if diff := cmp.Diff(tc.wantParent, cr.Status.AtProvider.ParentGuid); diff != "" {
+				t.Errorf("\n%s\nsyncHierarchy(...): -want parent, +got parent:\n%s\n", tc.reason, diff)
+			}
+			if got := cr.GetCondition(v1alpha1.HierarchyCondition).Status; got != tc.wantCondition {
+				t.Errorf("\n%s\nsyncHierarchy(...): want condition %s, got %s", tc.reason, tc.wantCondition, got)
+			}
+		})
+	}
+}
+
+func TestSameFeatures(t *testing.T) {
+	if !sameFeatures([]string{"DEFAULT", "AUTHORIZATIONS", "ENTITLEMENTS"}, []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"}) {
+		t.Errorf("sameFeatures(...): features must be compared independent of their order")
+	}
+	if sameFeatures([]string{"DEFAULT"}, []string{"DEFAULT", "ENTITLEMENTS"}) {
+		t.Errorf("sameFeatures(...): added features must be detected")
+	}
+}
@@ -0,0 +1,52 @@
+package entitlement
+
+import (
+	"cmp"
+	"time"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/batch"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+const (
+	// assignmentBatchWindow is how long assignments of concurrent reconciles are collected before they are sent together
+	assignmentBatchWindow = time.Second
+	// assignmentBatchTimeout limits the requests of a batch, it is independent of the reconciles waiting for it
+	assignmentBatchTimeout = time.Minute
+)
+
+// subaccountAssignments is shared by the controllers of cluster scoped and namespaced entitlements
+var subaccountAssignments = newAssignmentBatcher(assignmentBatchWindow)
+
+// setServicePlansFn sends the assignments of service plans as one request
+type setServicePlansFn = batch.SendFn[entclient.ServicePlanAssignmentRequestPayload]
+
+// batchKey separates batches per entitlements API client, which is shared by all entitlements of a ProviderConfig, and per subaccount
+type batchKey struct {
+	client     any
+	subaccount string
+}
+
+type planKey struct {
+	service  string
+	plan     string
+	uniqueID string
+}
+
+// assignmentBatcher collects the service plan assignments of a subaccount reconciled at the same time and sends them as one collection.
+type assignmentBatcher = batch.Batcher[batchKey, planKey, entclient.ServicePlanAssignmentRequestPayload]
+
+func newAssignmentBatcher(window time.Duration) *assignmentBatcher {
+	return batch.New[batchKey](window, assignmentBatchTimeout, keyOfPlan, comparePlans)
+}
+
+// comparePlans orders plans by service and plan
+func comparePlans(a, b entclient.ServicePlanAssignmentRequestPayload) int {
+	ka, kb := keyOfPlan(a), keyOfPlan(b)
+	return cmp.Or(cmp.Compare(ka.service, kb.service), cmp.Compare(ka.plan, kb.plan), cmp.Compare(ka.uniqueID, kb.uniqueID))
+}
+
+func keyOfPlan(plan entclient.ServicePlanAssignmentRequestPayload) planKey {
+	return planKey{service: plan.ServiceName, plan: plan.ServicePlanName, uniqueID: internal.Val(plan.ServicePlanUniqueIdentifier)}
+}
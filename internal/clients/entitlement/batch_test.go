@@ -0,0 +1,118 @@
+package entitlement
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+const errQuotaExceeded = "quota exceeded"
+
+// recordingSetServicePlans records the requests and rejects each request containing the failing plan
+type recordingSetServicePlans struct {
+	mu       sync.Mutex
+	requests [][]string
+	failing  string
+}
+
+func (r *recordingSetServicePlans) set(ctx context.Context, plans []entclient.ServicePlanAssignmentRequestPayload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	var err error
+	for _, p := range plans {
+		names = append(names, p.ServicePlanName)
+		if p.ServicePlanName == r.failing {
+			err = errors.New(errQuotaExceeded)
+		}
+	}
+	r.requests = append(r.requests, names)
+	return err
+}
+
+func assignPlans(batcher *assignmentBatcher, key batchKey, set setServicePlansFn, plans ...string) map[string]error {
+	var mu sync.Mutex
+	errs := map[string]error{}
+	var wg sync.WaitGroup
+	for _, name := range plans {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			err := batcher.Add(context.Background(), key, set, entclient.ServicePlanAssignmentRequestPayload{ServiceName: "hana-cloud", ServicePlanName: name})
+			mu.Lock()
+			errs[name] = err
+			mu.Unlock()
+		}(name)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestAssignmentBatcher(t *testing.T) {
+	tests := map[string]struct {
+		reason       string
+		failing      string
+		wantRequests [][]string
+		wantErrs     map[string]error
+	}{
+		"Batched": {
+			reason:       "Assignments of a subaccount collected within the window are sent as one request",
+			wantRequests: [][]string{{"hana", "relational-data-lake", "tools"}},
+			wantErrs:     map[string]error{"hana": nil, "relational-data-lake": nil, "tools": nil},
+		},
+		"Rejected": {
+			reason:  "A rejected collection is resent per plan to report the outcome of each entitlement",
+			failing: "relational-data-lake",
+			wantRequests: [][]string{
+				{"hana", "relational-data-lake", "tools"},
+				{"hana"}, {"relational-data-lake"}, {"tools"},
+			},
+			wantErrs: map[string]error{"hana": nil, "relational-data-lake": errors.New(errQuotaExceeded), "tools": nil},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			batcher := newAssignmentBatcher(50 * time.Millisecond)
+			api := &recordingSetServicePlans{failing: tc.failing}
+
+			errs := assignPlans(batcher, batchKey{client: &entclient.APIClient{}, subaccount: "sa-1"}, api.set, "tools", "hana", "relational-data-lake")
+
+			if diff := cmp.Diff(tc.wantRequests, api.requests); diff != "" {
+				t.Errorf("\n%s\nAssign(...): -want requests, +got requests:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantErrs, errs, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAssign(...): -want errors, +got errors:\n%s\n", tc.reason, diff)
+			}
+			if batcher.Pending() != 0 {
+				t.Errorf("\n%s\nAssign(...): sent batches must be removed", tc.reason)
+			}
+		})
+	}
+}
+
+func TestAssignmentBatcherPerSubaccount(t *testing.T) {
+	batcher := newAssignmentBatcher(50 * time.Millisecond)
+	api := &recordingSetServicePlans{}
+	client := &entclient.APIClient{}
+
+	var wg sync.WaitGroup
+	for _, subaccount := range []string{"sa-1", "sa-2"} {
+		wg.Add(1)
+		go func(subaccount string) {
+			defer wg.Done()
+			assignPlans(batcher, batchKey{client: client, subaccount: subaccount}, api.set, "hana")
+		}(subaccount)
+	}
+	wg.Wait()
+
+	if len(api.requests) != 2 {
+		t.Errorf("Assign(...): want one request per subaccount, got %d", len(api.requests))
+	}
+}
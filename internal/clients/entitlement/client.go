@@ -21,7 +21,8 @@ type Client interface {
 	DescribeInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) (*Instance, error)
 	CreateInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) error
 	DeleteInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) error
-	UpdateInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) error
+	UpdateInstance(ctx context.Context, cr *apisv1alpha1.Entitlement, pending ...*apisv1alpha1.Entitlement) error
+	QuotaPool(ctx context.Context, cr *apisv1alpha1.Entitlement) (string, error)
 }
 
 type Instance struct {
@@ -108,24 +109,29 @@ func calcSumOfAmount(entitlement apisv1alpha1.Entitlement, amountSum *int) (*int
 }
 
 func newAssigned(instance Instance) *apisv1alpha1.Assignable {
-	if instance.Assignment == nil {
+	return NewAssignable(instance.Assignment)
+}
+
+// NewAssignable maps the assignment of a plan to a subaccount or directory, nil if the plan is not assigned
+func NewAssignable(assignment *entclient.AssignedServicePlanSubaccountDTO) *apisv1alpha1.Assignable {
+	if assignment == nil {
 		return nil
 	}
 
-	assignment := apisv1alpha1.Assignable{
-		Amount:                  internal.Float32PtrToIntPtr(instance.Assignment.Amount),
-		AutoAssign:              internal.Val(instance.Assignment.AutoAssign),
-		AutoAssigned:            internal.Val(instance.Assignment.AutoAssigned),
-		AutoDistributeAmount:    internal.Val(instance.Assignment.AutoDistributeAmount),
-		RequestedAmount:         internal.Val(internal.Float32PtrToIntPtr(instance.Assignment.RequestedAmount)),
-		UnlimitedAmountAssigned: internal.Val(instance.Assignment.UnlimitedAmountAssigned),
-		Resources:               newResources(instance.Assignment.Resources),
-		StateMessage:            internal.Val(instance.Assignment.StateMessage),
-		EntityState:             internal.Val(instance.Assignment.EntityState),
-		EntityType:              internal.Val(instance.Assignment.EntityType),
-		EntityID:                internal.Val(instance.Assignment.EntityId),
+	assigned := apisv1alpha1.Assignable{
+		Amount:                  internal.Float32PtrToIntPtr(assignment.Amount),
+		AutoAssign:              internal.Val(assignment.AutoAssign),
+		AutoAssigned:            internal.Val(assignment.AutoAssigned),
+		AutoDistributeAmount:    internal.Val(assignment.AutoDistributeAmount),
+		RequestedAmount:         internal.Val(internal.Float32PtrToIntPtr(assignment.RequestedAmount)),
+		UnlimitedAmountAssigned: internal.Val(assignment.UnlimitedAmountAssigned),
+		Resources:               newResources(assignment.Resources),
+		StateMessage:            internal.Val(assignment.StateMessage),
+		EntityState:             internal.Val(assignment.EntityState),
+		EntityType:              internal.Val(assignment.EntityType),
+		EntityID:                internal.Val(assignment.EntityId),
 	}
-	return &assignment
+	return &assigned
 }
 
 func newEntitled(instance Instance) apisv1alpha1.Entitled {
@@ -2,12 +2,13 @@ package entitlement
 
 import (
 	"context"
-	"fmt"
+	"slices"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
 	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
 )
 
@@ -17,14 +18,21 @@ const (
 	errFailedSetEntitlements     = "failed to set entitlement for service %s/%s."
 	errServiceNotFoundByName     = "failed to find service with the given name %s"
 	errServicePlanNotFoundByName = "failed to find service plan with the given name %s"
+	errGetQuotaPool              = "failed to resolve the quota pool of subaccount %s"
+
+	featureEntitlements = "ENTITLEMENTS"
+	// maxHierarchyDepth bounds the directories walked up to the global account in case of an inconsistent hierarchy
+	maxHierarchyDepth = 10
 )
 
 type EntitlementsClient struct {
-	btp btp.Client
+	btp         btp.Client
+	assignments *assignmentBatcher
+	pools       *poolCache
 }
 
 func NewEntitlementsClient(btp btp.Client) *EntitlementsClient {
-	return &EntitlementsClient{btp: btp}
+	return &EntitlementsClient{btp: btp, assignments: subaccountAssignments, pools: quotaPools}
 
 }
 
@@ -67,6 +75,47 @@ func (c EntitlementsClient) DescribeInstance(
 	}, nil
 }
 
+// QuotaPool returns the GUID of the global account or directory the subaccount of cr draws its quota from. That is the
+// closest parent directory managing entitlements, or the global account if there is none. The pool is cached per subaccount.
+func (c EntitlementsClient) QuotaPool(ctx context.Context, cr *v1alpha1.Entitlement) (string, error) {
+	guid := cr.Spec.ForProvider.SubaccountGuid
+	if c.pools == nil {
+		return c.resolveQuotaPool(ctx, guid)
+	}
+	if pool, ok := c.pools.get(guid); ok {
+		return pool, nil
+	}
+	pool, err := c.resolveQuotaPool(ctx, guid)
+	if err != nil {
+		return "", err
+	}
+	c.pools.set(guid, pool)
+	return pool, nil
+}
+
+func (c EntitlementsClient) resolveQuotaPool(ctx context.Context, guid string) (string, error) {
+	accounts := c.btp.AccountsServiceClient
+	subaccount, raw, err := accounts.SubaccountOperationsAPI.GetSubaccount(ctx, guid).Execute()
+	if err != nil {
+		return "", errors.Wrapf(apierror.New(err, raw), errGetQuotaPool, guid)
+	}
+	if slices.Contains(subaccount.ParentFeatures, featureEntitlements) {
+		return subaccount.ParentGUID, nil
+	}
+	parent := subaccount.ParentGUID
+	for depth := 0; parent != "" && parent != subaccount.GlobalAccountGUID && depth < maxHierarchyDepth; depth++ {
+		directory, raw, err := accounts.DirectoryOperationsAPI.GetDirectory(ctx, parent).Execute()
+		if err != nil {
+			return "", errors.Wrapf(apierror.New(err, raw), errGetQuotaPool, guid)
+		}
+		if slices.Contains(directory.DirectoryFeatures, featureEntitlements) {
+			return directory.Guid, nil
+		}
+		parent = directory.ParentGUID
+	}
+	return subaccount.GlobalAccountGUID, nil
+}
+
 func (c EntitlementsClient) CreateInstance(ctx context.Context, cr *v1alpha1.Entitlement) error {
 	return c.UpdateInstance(ctx, cr)
 }
@@ -96,40 +145,54 @@ func (c EntitlementsClient) DeleteInstance(ctx context.Context, cr *v1alpha1.Ent
 	return c.UpdateInstance(ctx, cr)
 }
 
-func (c EntitlementsClient) UpdateInstance(ctx context.Context, cr *v1alpha1.Entitlement) error {
-	serviceName := cr.Spec.ForProvider.ServiceName
-	planName := cr.Spec.ForProvider.ServicePlanName
-	servicePlanUniqueIdentifier := cr.Spec.ForProvider.ServicePlanUniqueIdentifier
+// UpdateInstance assigns the required amount of the plan to the subaccount. Assignments of other plans of the same
+// subaccount that are updated at the same time are sent together in one request, as well as the pending assignments
+// of other plans, whose errors are left to the reconciles of their entitlements.
+func (c EntitlementsClient) UpdateInstance(ctx context.Context, cr *v1alpha1.Entitlement, pending ...*v1alpha1.Entitlement) error {
+	related := make([]entclient.ServicePlanAssignmentRequestPayload, 0, len(pending))
+	for _, p := range pending {
+		related = append(related, assignmentOf(p))
+	}
+
+	key := batchKey{client: c.btp.EntitlementsServiceClient, subaccount: cr.Spec.ForProvider.SubaccountGuid}
+	err := c.assignments.Add(ctx, key, c.setServicePlans, assignmentOf(cr), related...)
+
+	if err != nil {
+		if apiErr, ok := apierror.From(err); ok {
+			return apiErr
+		}
+		return errors.Wrapf(err, errFailedSetEntitlements, cr.Spec.ForProvider.ServiceName, cr.Spec.ForProvider.ServicePlanName)
+	}
+
+	return nil
+}
+
+// assignmentOf builds the assignment of the required amount of the plan to the subaccount
+func assignmentOf(cr *v1alpha1.Entitlement) entclient.ServicePlanAssignmentRequestPayload {
 	var amount *float32
 	if cr.Status.AtProvider.Required.Amount != nil {
 		amount = internal.Ptr(float32(*cr.Status.AtProvider.Required.Amount))
 	}
 
-	payload := entclient.NewSubaccountServicePlansRequestPayloadCollection(
-		[]entclient.ServicePlanAssignmentRequestPayload{
+	return entclient.ServicePlanAssignmentRequestPayload{
+		AssignmentInfo: []entclient.SubaccountServicePlanRequestPayload{
 			{
-				AssignmentInfo: []entclient.SubaccountServicePlanRequestPayload{
-					{
-						Amount:         amount,
-						Enable:         cr.Status.AtProvider.Required.Enable,
-						Resources:      nil,
-						SubaccountGUID: cr.Spec.ForProvider.SubaccountGuid,
-					},
-				},
-				ServiceName:                 serviceName,
-				ServicePlanName:             planName,
-				ServicePlanUniqueIdentifier: servicePlanUniqueIdentifier,
+				Amount:         amount,
+				Enable:         cr.Status.AtProvider.Required.Enable,
+				Resources:      nil,
+				SubaccountGUID: cr.Spec.ForProvider.SubaccountGuid,
 			},
 		},
-	)
-
-	_, _, err := c.btp.EntitlementsServiceClient.SetServicePlans(ctx).SubaccountServicePlansRequestPayloadCollection(*payload).Execute()
-
-	if err != nil {
-		return specifyAPIError(err, errors.Wrapf(err, errFailedSetEntitlements, serviceName, planName))
+		ServiceName:                 cr.Spec.ForProvider.ServiceName,
+		ServicePlanName:             cr.Spec.ForProvider.ServicePlanName,
+		ServicePlanUniqueIdentifier: cr.Spec.ForProvider.ServicePlanUniqueIdentifier,
 	}
+}
 
-	return nil
+func (c EntitlementsClient) setServicePlans(ctx context.Context, plans []entclient.ServicePlanAssignmentRequestPayload) error {
+	payload := entclient.NewSubaccountServicePlansRequestPayloadCollection(plans)
+	_, raw, err := c.btp.EntitlementsServiceClient.SetServicePlans(ctx).SubaccountServicePlansRequestPayloadCollection(*payload).Execute()
+	return apierror.New(err, raw)
 }
 
 // findAssignedServicePlan returns the assignment for the given service and service plan, if it exists
@@ -262,16 +325,3 @@ func float64Pointer(val *int) *float64 {
 func isCompleteDeletion(cr *v1alpha1.Entitlement) bool {
 	return cr.Status.AtProvider.Required.Amount == nil && cr.Status.AtProvider.Required.Enable == nil
 }
-
-func specifyAPIError(err error, fallbackErr error) error {
-	if genericErr, ok := err.(*entclient.GenericOpenAPIError); ok {
-		if provisionErr, ok := genericErr.Model().(entclient.ApiExceptionResponseObject); ok {
-			return errors.New(fmt.Sprintf("API Error: %v, Code %v", internal.Val(provisionErr.Error.Message), internal.Val(provisionErr.Error.Code)))
-		}
-		if genericErr.Body() != nil {
-			return fmt.Errorf("API Error: %s", string(genericErr.Body()))
-		}
-
-	}
-	return fallbackErr
-}
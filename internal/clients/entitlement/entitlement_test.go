@@ -1,12 +1,17 @@
 package entitlement
 
 import (
+	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
 
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
@@ -450,3 +455,109 @@ func TestFilterEntitledServices(t *testing.T) {
 		)
 	}
 }
+
+type mockSubaccountAPI struct {
+	accountclient.SubaccountOperationsAPI
+	subaccount *accountclient.SubaccountResponseObject
+}
+
+func (m mockSubaccountAPI) GetSubaccount(ctx context.Context, subaccountGUID string) accountclient.ApiGetSubaccountRequest {
+	return accountclient.ApiGetSubaccountRequest{ApiService: m}
+}
+
+func (m mockSubaccountAPI) GetSubaccountExecute(r accountclient.ApiGetSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
+	return m.subaccount, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+type mockDirectoryAPI struct {
+	accountclient.DirectoryOperationsAPI
+	directories map[string]*accountclient.DirectoryResponseObject
+	requested   string
+}
+
+func (m mockDirectoryAPI) GetDirectory(ctx context.Context, directoryGUID string) accountclient.ApiGetDirectoryRequest {
+	m.requested = directoryGUID
+	return accountclient.ApiGetDirectoryRequest{ApiService: m}
+}
+
+func (m mockDirectoryAPI) GetDirectoryExecute(r accountclient.ApiGetDirectoryRequest) (*accountclient.DirectoryResponseObject, *http.Response, error) {
+	if dir, ok := m.directories[m.requested]; ok {
+		return dir, &http.Response{StatusCode: http.StatusOK}, nil
+	}
+	return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("directory not found")
+}
+
+func TestQuotaPool(t *testing.T) {
+	directories := map[string]*accountclient.DirectoryResponseObject{
+		"plain":    {Guid: "plain", ParentGUID: "entitled", DirectoryFeatures: []string{"DEFAULT"}},
+		"entitled": {Guid: "entitled", ParentGUID: "ga", DirectoryFeatures: []string{"DEFAULT", "ENTITLEMENTS"}},
+		"root":     {Guid: "root", ParentGUID: "ga", DirectoryFeatures: []string{"DEFAULT"}},
+	}
+	tests := map[string]struct {
+		reason     string
+		subaccount *accountclient.SubaccountResponseObject
+		want       string
+	}{
+		"GlobalAccount": {
+			reason:     "Subaccounts of the global account draw from the global account",
+			subaccount: &accountclient.SubaccountResponseObject{GlobalAccountGUID: "ga", ParentGUID: "ga"},
+			want:       "ga",
+		},
+		"Parent": {
+			reason:     "Subaccounts of a directory managing entitlements draw from the directory",
+			subaccount: &accountclient.SubaccountResponseObject{GlobalAccountGUID: "ga", ParentGUID: "entitled", ParentFeatures: []string{"DEFAULT", "ENTITLEMENTS"}},
+			want:       "entitled",
+		},
+		"Ancestor": {
+			reason:     "Subaccounts draw from the closest directory managing entitlements",
+			subaccount: &accountclient.SubaccountResponseObject{GlobalAccountGUID: "ga", ParentGUID: "plain", ParentFeatures: []string{"DEFAULT"}},
+			want:       "entitled",
+		},
+		"NoEntitlementsDirectory": {
+			reason:     "Subaccounts without a directory managing entitlements draw from the global account",
+			subaccount: &accountclient.SubaccountResponseObject{GlobalAccountGUID: "ga", ParentGUID: "root", ParentFeatures: []string{"DEFAULT"}},
+			want:       "ga",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := EntitlementsClient{btp: btp.Client{AccountsServiceClient: &accountclient.APIClient{
+				SubaccountOperationsAPI: mockSubaccountAPI{subaccount: tc.subaccount},
+				DirectoryOperationsAPI:  mockDirectoryAPI{directories: directories},
+			}}}
+			got, err := client.QuotaPool(context.Background(), &v1alpha1.Entitlement{})
+			if err != nil {
+				t.Fatalf("\n%s\nQuotaPool(...): %v", tc.reason, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\nQuotaPool(...): want %s, got %s", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestQuotaPoolCached(t *testing.T) {
+	now := time.Now()
+	pools := newPoolCache(time.Minute)
+	pools.now = func() time.Time { return now }
+	accounts := &accountclient.APIClient{SubaccountOperationsAPI: mockSubaccountAPI{
+		subaccount: &accountclient.SubaccountResponseObject{GlobalAccountGUID: "ga", ParentGUID: "ga"},
+	}}
+	client := EntitlementsClient{btp: btp.Client{AccountsServiceClient: accounts}, pools: pools}
+	cr := &v1alpha1.Entitlement{Spec: v1alpha1.EntitlementSpec{ForProvider: v1alpha1.EntitlementParameters{SubaccountGuid: "sa"}}}
+
+	if got, _ := client.QuotaPool(context.Background(), cr); got != "ga" {
+		t.Fatalf("QuotaPool(...): want ga, got %s", got)
+	}
+	// the subaccount moves to a directory managing entitlements
+	accounts.SubaccountOperationsAPI = mockSubaccountAPI{
+		subaccount: &accountclient.SubaccountResponseObject{GlobalAccountGUID: "ga", ParentGUID: "entitled", ParentFeatures: []string{"ENTITLEMENTS"}},
+	}
+	if got, _ := client.QuotaPool(context.Background(), cr); got != "ga" {
+		t.Errorf("QuotaPool(...): want the cached pool ga within the TTL, got %s", got)
+	}
+	now = now.Add(2 * time.Minute)
+	if got, _ := client.QuotaPool(context.Background(), cr); got != "entitled" {
+		t.Errorf("QuotaPool(...): want the pool to be resolved again after the TTL, got %s", got)
+	}
+}
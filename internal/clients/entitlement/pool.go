@@ -0,0 +1,55 @@
+package entitlement
+
+import (
+	"sync"
+	"time"
+)
+
+// quotaPoolTTL is how long the quota pool resolved for a subaccount is reused. Subaccounts are rarely moved, a move is
+// picked up once the entry expired.
+const quotaPoolTTL = 10 * time.Minute
+
+// quotaPools is shared by the controllers of cluster scoped and namespaced entitlements, so that the hierarchy of a
+// subaccount is resolved once for all of its entitlements instead of on every observation.
+var quotaPools = newPoolCache(quotaPoolTTL)
+
+// poolCache caches the quota pool per subaccount GUID
+type poolCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]cachedPool
+}
+
+type cachedPool struct {
+	pool     string
+	resolved time.Time
+}
+
+func newPoolCache(ttl time.Duration) *poolCache {
+	return &poolCache{ttl: ttl, now: time.Now, entries: map[string]cachedPool{}}
+}
+
+// get returns the pool of the subaccount if it has been resolved within the TTL
+func (c *poolCache) get(subaccount string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[subaccount]
+	if !ok || c.now().Sub(entry.resolved) > c.ttl {
+		return "", false
+	}
+	return entry.pool, true
+}
+
+// set records the pool of the subaccount and evicts expired entries, e.g. of deleted subaccounts
+func (c *poolCache) set(subaccount, pool string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for guid, entry := range c.entries {
+		if now.Sub(entry.resolved) > c.ttl {
+			delete(c.entries, guid)
+		}
+	}
+	c.entries[subaccount] = cachedPool{pool: pool, resolved: now}
+}
@@ -0,0 +1,136 @@
+package entitlement
+
+import (
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+const (
+	msgQuotaExceeded      = "entitlement requires %d more of %s/%s, but only %d of %d remain to be assigned"
+	msgSubaccountQuota    = "entitlement requires %d of %s/%s, but at most %d can be assigned to a subaccount"
+	msgQuotaOvercommitted = "entitlements of %d subaccounts require %d more of %s/%s, but only %d of %d remain to be assigned; assignments will fail once the quota is used up"
+)
+
+// PlanQuota accounts the quota of the service plan of cr against all managed entitlements of that plan drawing from the same pool.
+// planEntitlements holds the entitlements of all subaccounts for the same service and plan, the observation of cr must already be generated.
+// pool is the global account or directory the subaccount of cr draws its quota from, see EntitlementsClient.QuotaPool.
+// Other subaccounts are accounted with the assignment last observed on their entitlements, or with their full amount if none was observed yet.
+// Their pool is taken from the quota last observed on their entitlements, subaccounts whose pool is not known yet are accounted as well.
+// Subaccounts with invalid entitlements are skipped, they are reported on their own entitlements.
+// Returns nil for plans without a numeric quota.
+func PlanQuota(cr *apisv1alpha1.Entitlement, pool string, planEntitlements *apisv1alpha1.EntitlementList) *apisv1alpha1.QuotaUsage {
+	if !HasPlanQuota(cr) {
+		return nil
+	}
+	observation := cr.Status.AtProvider
+	usage := &apisv1alpha1.QuotaUsage{
+		Pool:             pool,
+		Entitled:         observation.Entitled.Amount,
+		Remaining:        observation.Entitled.RemainingAmount,
+		MaxPerSubaccount: observation.Entitled.MaxAllowedSubaccountQuota,
+		Requested:        pendingAmount(observation.Required.Amount, observation.Assigned),
+		Subaccounts:      1,
+	}
+	usage.Pending = usage.Requested
+
+	for guid, related := range groupBySubaccount(planEntitlements) {
+		if guid == cr.Spec.ForProvider.SubaccountGuid {
+			continue
+		}
+		if otherPool := lastPool(related); otherPool != "" && otherPool != pool {
+			continue
+		}
+		required, err := MergeRelatedEntitlements(related)
+		if err != nil {
+			continue
+		}
+		usage.Subaccounts++
+		usage.Pending += pendingAmount(required.Amount, lastAssigned(related))
+	}
+	return usage
+}
+
+// ValidateQuota returns an error if the amount required for the subaccount can't be assigned with the quota left.
+// The API would accept such a request and fail processing it afterwards, leaving the assignment in PROCESSING_FAILED.
+func ValidateQuota(cr *apisv1alpha1.Entitlement) error {
+	if cr.Status.AtProvider == nil || cr.Status.AtProvider.Quota == nil {
+		return nil
+	}
+	usage := cr.Status.AtProvider.Quota
+	service, plan := cr.Spec.ForProvider.ServiceName, cr.Spec.ForProvider.ServicePlanName
+	required := internal.Val(cr.Status.AtProvider.Required.Amount)
+	if usage.MaxPerSubaccount > 0 && required > usage.MaxPerSubaccount {
+		return fmt.Errorf(msgSubaccountQuota, required, service, plan, usage.MaxPerSubaccount)
+	}
+	if usage.Requested > usage.Remaining {
+		return fmt.Errorf(msgQuotaExceeded, usage.Requested, service, plan, usage.Remaining, usage.Entitled)
+	}
+	return nil
+}
+
+// QuotaCondition reports the quota usage of cr, it is only meaningful for entitlements with a QuotaUsage.
+func QuotaCondition(cr *apisv1alpha1.Entitlement) xpv1.Condition {
+	if err := ValidateQuota(cr); err != nil {
+		return apisv1alpha1.QuotaExceeded(err.Error())
+	}
+	usage := cr.Status.AtProvider.Quota
+	if usage.Pending > usage.Remaining {
+		return apisv1alpha1.QuotaOvercommitted(fmt.Sprintf(msgQuotaOvercommitted, usage.Subaccounts, usage.Pending,
+			cr.Spec.ForProvider.ServiceName, cr.Spec.ForProvider.ServicePlanName, usage.Remaining, usage.Entitled))
+	}
+	return apisv1alpha1.QuotaAvailable()
+}
+
+// HasPlanQuota returns true if the plan is known and assigned by a numeric amount
+func HasPlanQuota(cr *apisv1alpha1.Entitlement) bool {
+	observation := cr.Status.AtProvider
+	if observation == nil || observation.Required == nil || observation.Required.Amount == nil {
+		return false
+	}
+	return observation.Entitled.Name != "" && !observation.Entitled.Unlimited
+}
+
+// pendingAmount returns the amount required on top of the assigned one, decreases free up quota and never count as pending
+func pendingAmount(required *int, assigned *apisv1alpha1.Assignable) int {
+	current := 0
+	if assigned != nil {
+		current = internal.Val(assigned.Amount)
+	}
+	return max(internal.Val(required)-current, 0)
+}
+
+// lastAssigned returns the assignment last observed on any of the entitlements
+func lastAssigned(related *apisv1alpha1.EntitlementList) *apisv1alpha1.Assignable {
+	for _, ent := range related.Items {
+		if ent.Status.AtProvider != nil && ent.Status.AtProvider.Assigned != nil {
+			return ent.Status.AtProvider.Assigned
+		}
+	}
+	return nil
+}
+
+// lastPool returns the quota pool last observed on any of the entitlements
+func lastPool(related *apisv1alpha1.EntitlementList) string {
+	for _, ent := range related.Items {
+		if ent.Status.AtProvider != nil && ent.Status.AtProvider.Quota != nil && ent.Status.AtProvider.Quota.Pool != "" {
+			return ent.Status.AtProvider.Quota.Pool
+		}
+	}
+	return ""
+}
+
+func groupBySubaccount(entitlements *apisv1alpha1.EntitlementList) map[string]*apisv1alpha1.EntitlementList {
+	groups := map[string]*apisv1alpha1.EntitlementList{}
+	for _, ent := range entitlements.Items {
+		guid := ent.Spec.ForProvider.SubaccountGuid
+		if groups[guid] == nil {
+			groups[guid] = &apisv1alpha1.EntitlementList{}
+		}
+		groups[guid].Items = append(groups[guid].Items, ent)
+	}
+	return groups
+}
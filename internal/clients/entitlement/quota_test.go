@@ -0,0 +1,161 @@
+package entitlement
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+func planEntitlement(subaccount string, amount int, assigned *int) v1alpha1.Entitlement {
+	ent := v1alpha1.Entitlement{Spec: v1alpha1.EntitlementSpec{ForProvider: v1alpha1.EntitlementParameters{
+		ServiceName:     "hana-cloud",
+		ServicePlanName: "hana",
+		SubaccountGuid:  subaccount,
+		Amount:          internal.Ptr(amount),
+	}}}
+	if assigned != nil {
+		ent.Status.AtProvider = &v1alpha1.EntitlementObservation{Assigned: &v1alpha1.Assignable{Amount: assigned}}
+	}
+	return ent
+}
+
+func pooledEntitlement(subaccount string, amount int, pool string) v1alpha1.Entitlement {
+	ent := planEntitlement(subaccount, amount, nil)
+	ent.Status.AtProvider = &v1alpha1.EntitlementObservation{Quota: &v1alpha1.QuotaUsage{Pool: pool}}
+	return ent
+}
+
+func observedEntitlement(required int, assigned *int, entitled v1alpha1.Entitled) *v1alpha1.Entitlement {
+	ent := planEntitlement("ours", required, nil)
+	ent.Status.AtProvider = &v1alpha1.EntitlementObservation{
+		Required: &v1alpha1.EntitlementSummary{Amount: internal.Ptr(required)},
+		Entitled: entitled,
+	}
+	if assigned != nil {
+		ent.Status.AtProvider.Assigned = &v1alpha1.Assignable{Amount: assigned}
+	}
+	return &ent
+}
+
+func TestPlanQuota(t *testing.T) {
+	entitled := v1alpha1.Entitled{Name: "hana", Amount: 10, RemainingAmount: 4}
+	tests := map[string]struct {
+		reason  string
+		cr      *v1alpha1.Entitlement
+		related []v1alpha1.Entitlement
+		want    *v1alpha1.QuotaUsage
+	}{
+		"NotEntitled": {
+			reason: "Plans that are not entitled have no quota to account",
+			cr:     observedEntitlement(2, nil, v1alpha1.Entitled{}),
+		},
+		"Unlimited": {
+			reason: "Plans without a numeric quota have no quota to account",
+			cr:     observedEntitlement(2, nil, v1alpha1.Entitled{Name: "hana", Unlimited: true}),
+		},
+		"Increase": {
+			reason: "Only the amount on top of the current assignment is requested",
+			cr:     observedEntitlement(5, internal.Ptr(2), entitled),
+			related: []v1alpha1.Entitlement{
+				planEntitlement("ours", 5, internal.Ptr(2)),
+			},
+			want: &v1alpha1.QuotaUsage{Pool: "ga", Entitled: 10, Remaining: 4, Requested: 3, Pending: 3, Subaccounts: 1},
+		},
+		"Decrease": {
+			reason: "Decreasing an assignment requests no quota",
+			cr:     observedEntitlement(1, internal.Ptr(2), entitled),
+			want:   &v1alpha1.QuotaUsage{Pool: "ga", Entitled: 10, Remaining: 4, Requested: 0, Pending: 0, Subaccounts: 1},
+		},
+		"OtherSubaccounts": {
+			reason: "Pending amounts of all subaccounts of the plan are aggregated, merged per subaccount",
+			cr:     observedEntitlement(3, nil, entitled),
+			related: []v1alpha1.Entitlement{
+				planEntitlement("ours", 3, nil),
+				planEntitlement("other", 2, internal.Ptr(1)),
+				planEntitlement("other", 1, nil),
+				planEntitlement("new", 2, nil),
+			},
+			want: &v1alpha1.QuotaUsage{Pool: "ga", Entitled: 10, Remaining: 4, Requested: 3, Pending: 7, Subaccounts: 3},
+		},
+		"OtherPools": {
+			reason: "Subaccounts drawing from another pool are not accounted, subaccounts whose pool is not known yet are",
+			cr:     observedEntitlement(3, nil, entitled),
+			related: []v1alpha1.Entitlement{
+				pooledEntitlement("same", 2, "ga"),
+				pooledEntitlement("directory", 4, "dir"),
+				planEntitlement("new", 1, nil),
+			},
+			want: &v1alpha1.QuotaUsage{Pool: "ga", Entitled: 10, Remaining: 4, Requested: 3, Pending: 6, Subaccounts: 3},
+		},
+		"InvalidOtherSubaccount": {
+			reason: "Subaccounts with invalid entitlements are not accounted",
+			cr:     observedEntitlement(3, nil, entitled),
+			related: []v1alpha1.Entitlement{
+				planEntitlement("other", -2, nil),
+			},
+			want: &v1alpha1.QuotaUsage{Pool: "ga", Entitled: 10, Remaining: 4, Requested: 3, Pending: 3, Subaccounts: 1},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := PlanQuota(tc.cr, "ga", &v1alpha1.EntitlementList{Items: tc.related})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPlanQuota(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestQuotaCondition(t *testing.T) {
+	tests := map[string]struct {
+		reason     string
+		required   int
+		usage      v1alpha1.QuotaUsage
+		wantReason xpv1.ConditionReason
+		wantErr    bool
+	}{
+		"Available": {
+			reason:     "Requests within the remaining quota can be assigned",
+			required:   3,
+			usage:      v1alpha1.QuotaUsage{Entitled: 10, Remaining: 4, Requested: 3, Pending: 3},
+			wantReason: v1alpha1.QuotaAvailableReason,
+		},
+		"Overcommitted": {
+			reason:     "Requests of other subaccounts exceeding the quota are only reported",
+			required:   3,
+			usage:      v1alpha1.QuotaUsage{Entitled: 10, Remaining: 4, Requested: 3, Pending: 7},
+			wantReason: v1alpha1.QuotaOvercommittedReason,
+		},
+		"Exceeded": {
+			reason:     "Requests exceeding the remaining quota must not be sent to the API",
+			required:   5,
+			usage:      v1alpha1.QuotaUsage{Entitled: 10, Remaining: 4, Requested: 5, Pending: 5},
+			wantReason: v1alpha1.QuotaExceededReason,
+			wantErr:    true,
+		},
+		"MaxPerSubaccount": {
+			reason:     "Requests exceeding the maximum quota of a subaccount must not be sent to the API",
+			required:   3,
+			usage:      v1alpha1.QuotaUsage{Entitled: 10, Remaining: 4, MaxPerSubaccount: 2, Requested: 1, Pending: 1},
+			wantReason: v1alpha1.QuotaExceededReason,
+			wantErr:    true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := observedEntitlement(tc.required, nil, v1alpha1.Entitled{})
+			cr.Status.AtProvider.Quota = &tc.usage
+
+			if err := ValidateQuota(cr); (err != nil) != tc.wantErr {
+				t.Errorf("\n%s\nValidateQuota(...): want error %t, got %v", tc.reason, tc.wantErr, err)
+			}
+			if got := QuotaCondition(cr).Reason; got != tc.wantReason {
+				t.Errorf("\n%s\nQuotaCondition(...): want reason %s, got %s", tc.reason, tc.wantReason, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package entitlement
+
+import (
+	"slices"
+	"strings"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+// Regions maps the data centers available to the global account sorted by name
+func Regions(dataCenters *entclient.DataCenterResponseCollection) []apisv1alpha1.CatalogRegion {
+	regions := []apisv1alpha1.CatalogRegion{}
+	for _, dc := range dataCenters.Datacenters {
+		regions = append(regions, apisv1alpha1.CatalogRegion{
+			Name:          internal.Val(dc.Name),
+			Region:        internal.Val(dc.Region),
+			DisplayName:   internal.Val(dc.DisplayName),
+			Environment:   internal.Val(dc.Environment),
+			IaasProvider:  internal.Val(dc.IaasProvider),
+			SupportsTrial: internal.Val(dc.SupportsTrial),
+		})
+	}
+	slices.SortFunc(regions, func(a, b apisv1alpha1.CatalogRegion) int { return strings.Compare(a.Name, b.Name) })
+	return regions
+}
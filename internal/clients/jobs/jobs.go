@@ -0,0 +1,159 @@
+// Package jobs tracks asynchronous operations of the BTP APIs via their job management endpoints.
+// Operations like creating or deleting subaccounts return a job, whose status tells if the operation is
+// still processing, completed or failed, independent of the state strings of the entity itself.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+const (
+	// Operations jobs are recorded for
+	OperationCreate = "Create"
+	OperationDelete = "Delete"
+
+	// MinPollInterval is the backoff after the first poll, it doubles with every further poll up to MaxPollInterval
+	MinPollInterval = 5 * time.Second
+	MaxPollInterval = 2 * time.Minute
+
+	jobsPathSegment = "jobs"
+
+	errGetStatus    = "cannot get status of job %s"
+	errDecodeStatus = "cannot decode status of job %s"
+
+	errUnexpectedResponse = "unexpected response for status of job %s"
+)
+
+// Status is the status of a job as returned by the job management APIs.
+type Status struct {
+	Status      string `json:"status"`
+	Description string `json:"description"`
+}
+
+// StatusSource returns the status of a job, it returns nil if the job is unknown to the API, e.g. since it expired.
+type StatusSource interface {
+	JobStatus(ctx context.Context, id string) (*Status, error)
+}
+
+// Tracker polls the status of recorded jobs with exponential backoff.
+type Tracker struct {
+	source StatusSource
+	now    func() time.Time
+}
+
+func NewTracker(source StatusSource) *Tracker {
+	return &Tracker{source: source, now: time.Now}
+}
+
+// Start records the job referenced by the response of an asynchronous operation. The job management APIs return
+// the status URL of the job as Location header, nil is returned if the response doesn't refer to a job.
+func Start(operation string, resp *http.Response) *v1alpha1.AsyncJob {
+	id := IDFromResponse(resp)
+	if id == "" {
+		return nil
+	}
+	return &v1alpha1.AsyncJob{
+		ID:        id,
+		Operation: operation,
+		Status:    v1alpha1.JobStatusInProgress,
+		StartTime: metav1.Now(),
+	}
+}
+
+// IDFromResponse extracts the job ID from a Location header like /jobs-management/v1/jobs/{jobID}/status.
+func IDFromResponse(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(resp.Header.Get("Location"), "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == jobsPathSegment {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// InProgress returns true as long as the job of the operation is still processing.
+func InProgress(job *v1alpha1.AsyncJob, operation string) bool {
+	return job != nil && job.Operation == operation && job.Status == v1alpha1.JobStatusInProgress
+}
+
+// Poll refreshes the status of an unfinished job once its backoff elapsed. It returns false if the job is unknown
+// to the API, in which case the job should no longer be tracked.
+func (t *Tracker) Poll(ctx context.Context, job *v1alpha1.AsyncJob) (bool, error) {
+	if job.Status != v1alpha1.JobStatusInProgress || !t.due(job) {
+		return true, nil
+	}
+	status, err := t.source.JobStatus(ctx, job.ID)
+	if err != nil {
+		return true, errors.Wrapf(err, errGetStatus, job.ID)
+	}
+	if status == nil {
+		return false, nil
+	}
+	now := metav1.NewTime(t.now())
+	job.LastPollTime = &now
+	job.Polls++
+	job.Status = status.Status
+	job.Description = status.Description
+	return true, nil
+}
+
+func (t *Tracker) due(job *v1alpha1.AsyncJob) bool {
+	if job.LastPollTime == nil {
+		return true
+	}
+	return !t.now().Before(job.LastPollTime.Add(Backoff(job.Polls)))
+}
+
+// Backoff returns the interval to wait after the given number of polls.
+func Backoff(polls int) time.Duration {
+	interval := MinPollInterval
+	for i := 1; i < polls && interval < MaxPollInterval; i++ {
+		interval *= 2
+	}
+	if interval > MaxPollInterval {
+		return MaxPollInterval
+	}
+	return interval
+}
+
+// accountsSource reads job statuses of the accounts service. Its generated client declares the status as plain
+// string, so the json object is decoded from the raw response instead.
+type accountsSource struct {
+	api accountclient.JobManagementAPI
+}
+
+func NewAccountsSource(api accountclient.JobManagementAPI) StatusSource {
+	return &accountsSource{api: api}
+}
+
+func (s *accountsSource) JobStatus(ctx context.Context, id string) (*Status, error) {
+	_, raw, err := s.api.GetStatus(ctx, id).Execute()
+	if raw != nil && raw.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if raw == nil || raw.StatusCode != http.StatusOK {
+		if err == nil {
+			return nil, errors.Errorf(errUnexpectedResponse, id)
+		}
+		return nil, apierror.New(err, raw)
+	}
+	status := &Status{}
+	if err := json.NewDecoder(raw.Body).Decode(status); err != nil {
+		return nil, errors.Wrapf(err, errDecodeStatus, id)
+	}
+	return status, nil
+}
@@ -0,0 +1,169 @@
+package jobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+type fakeSource struct {
+	status *Status
+	err    error
+	calls  int
+}
+
+func (f *fakeSource) JobStatus(ctx context.Context, id string) (*Status, error) {
+	f.calls++
+	return f.status, f.err
+}
+
+func TestIDFromResponse(t *testing.T) {
+	cases := map[string]struct {
+		location string
+		want     string
+	}{
+		"StatusURL":   {location: "/jobs-management/v1/jobs/1234/status", want: "1234"},
+		"AbsoluteURL": {location: "https://accounts-service.cfapps.eu10.hana.ondemand.com/jobs-management/v1/jobs/abc-1/status", want: "abc-1"},
+		"NoJob":       {location: "/accounts/v1/subaccounts/123", want: ""},
+		"NoLocation":  {want: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tc.location != "" {
+				resp.Header.Set("Location", tc.location)
+			}
+			if got := IDFromResponse(resp); got != tc.want {
+				t.Errorf("IDFromResponse(...): want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:  MinPollInterval,
+		1:  MinPollInterval,
+		2:  10 * time.Second,
+		4:  40 * time.Second,
+		10: MaxPollInterval,
+	}
+	for polls, want := range cases {
+		if got := Backoff(polls); got != want {
+			t.Errorf("Backoff(%d): want %s, got %s", polls, want, got)
+		}
+	}
+}
+
+func TestPoll(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastPoll := metav1.NewTime(now.Add(-7 * time.Second))
+
+	cases := map[string]struct {
+		job       v1alpha1.AsyncJob
+		source    *fakeSource
+		wantJob   v1alpha1.AsyncJob
+		wantKnown bool
+		wantCalls int
+	}{
+		"FirstPoll": {
+			job:       v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress},
+			source:    &fakeSource{status: &Status{Status: v1alpha1.JobStatusInProgress, Description: "Processing"}},
+			wantJob:   v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress, Description: "Processing", Polls: 1, LastPollTime: &metav1.Time{Time: now}},
+			wantKnown: true,
+			wantCalls: 1,
+		},
+		"BackoffNotElapsed": {
+			job:       v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress, Polls: 2, LastPollTime: &lastPoll},
+			source:    &fakeSource{status: &Status{Status: v1alpha1.JobStatusCompleted}},
+			wantJob:   v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress, Polls: 2, LastPollTime: &lastPoll},
+			wantKnown: true,
+		},
+		"Failed": {
+			job:       v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress, Polls: 1, LastPollTime: &lastPoll},
+			source:    &fakeSource{status: &Status{Status: v1alpha1.JobStatusFailed, Description: "quota exceeded"}},
+			wantJob:   v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusFailed, Description: "quota exceeded", Polls: 2, LastPollTime: &metav1.Time{Time: now}},
+			wantKnown: true,
+			wantCalls: 1,
+		},
+		"Finished": {
+			job:       v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusCompleted},
+			source:    &fakeSource{},
+			wantJob:   v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusCompleted},
+			wantKnown: true,
+		},
+		"Unknown": {
+			job:       v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress},
+			source:    &fakeSource{},
+			wantJob:   v1alpha1.AsyncJob{ID: "1", Status: v1alpha1.JobStatusInProgress},
+			wantKnown: false,
+			wantCalls: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tracker := &Tracker{source: tc.source, now: func() time.Time { return now }}
+			known, err := tracker.Poll(context.Background(), &tc.job)
+			if err != nil {
+				t.Fatalf("Poll(...): %v", err)
+			}
+			if known != tc.wantKnown {
+				t.Errorf("Poll(...): want known %t, got %t", tc.wantKnown, known)
+			}
+			if tc.source.calls != tc.wantCalls {
+				t.Errorf("Poll(...): want %d status requests, got %d", tc.wantCalls, tc.source.calls)
+			}
+			if diff := cmp.Diff(tc.wantJob, tc.job); diff != "" {
+				t.Errorf("Poll(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAccountsSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/jobs-management/v1/jobs/running/status":
+			_, _ = w.Write([]byte(`{"status":"IN_PROGRESS","description":"Creating subaccount"}`))
+		case "/jobs-management/v1/jobs/failed/status":
+			_, _ = w.Write([]byte(`{"status":"FAILED","description":"quota exceeded"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := accountclient.NewConfiguration()
+	config.Servers = accountclient.ServerConfigurations{{URL: server.URL}}
+	source := NewAccountsSource(accountclient.NewAPIClient(config).JobManagementAPI)
+
+	got, err := source.JobStatus(context.Background(), "running")
+	if err != nil {
+		t.Fatalf("JobStatus(...): %v", err)
+	}
+	if diff := cmp.Diff(&Status{Status: v1alpha1.JobStatusInProgress, Description: "Creating subaccount"}, got); diff != "" {
+		t.Errorf("JobStatus(...): -want, +got:\n%s\n", diff)
+	}
+
+	got, err = source.JobStatus(context.Background(), "failed")
+	if err != nil {
+		t.Fatalf("JobStatus(...): %v", err)
+	}
+	if diff := cmp.Diff(&Status{Status: v1alpha1.JobStatusFailed, Description: "quota exceeded"}, got); diff != "" {
+		t.Errorf("JobStatus(...): -want, +got:\n%s\n", diff)
+	}
+
+	got, err = source.JobStatus(context.Background(), "expired")
+	if err != nil || got != nil {
+		t.Errorf("JobStatus(...): want unknown job, got %v, %v", got, err)
+	}
+}
@@ -2,6 +2,7 @@ package environments
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
@@ -10,6 +11,7 @@ import (
 type MockProvisioningServiceClient struct {
 	err         error
 	apiResponse *client.BusinessEnvironmentInstancesResponseCollection
+	requestedId string
 }
 
 // CreateEnvironmentInstance implements openapi.EnvironmentsAPI.
@@ -104,7 +106,8 @@ func (m *MockProvisioningServiceClient) GetAvailableEnvironmentsExecute(r client
 
 // GetEnvironmentInstance implements openapi.EnvironmentsAPI.
 func (m *MockProvisioningServiceClient) GetEnvironmentInstance(ctx context.Context, environmentInstanceId string) client.ApiGetEnvironmentInstanceRequest {
-	panic("unimplemented")
+	m.requestedId = environmentInstanceId
+	return client.ApiGetEnvironmentInstanceRequest{ApiService: m}
 }
 
 // GetEnvironmentInstanceBinding implements openapi.EnvironmentsAPI.
@@ -118,8 +121,19 @@ func (m *MockProvisioningServiceClient) GetEnvironmentInstanceBindingExecute(r c
 }
 
 // GetEnvironmentInstanceExecute implements openapi.EnvironmentsAPI.
+// Serves the instance with the requested id out of the configured list response, responds with 404 if there is none.
 func (m *MockProvisioningServiceClient) GetEnvironmentInstanceExecute(r client.ApiGetEnvironmentInstanceRequest) (*client.BusinessEnvironmentInstanceResponseObject, *http.Response, error) {
-	panic("unimplemented")
+	if m.err != nil {
+		return nil, &http.Response{StatusCode: http.StatusInternalServerError}, m.err
+	}
+	if m.apiResponse != nil {
+		for _, instance := range m.apiResponse.EnvironmentInstances {
+			if instance.Id != nil && *instance.Id == m.requestedId {
+				return &instance, &http.Response{StatusCode: http.StatusOK}, nil
+			}
+		}
+	}
+	return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New(http.StatusText(http.StatusNotFound))
 }
 
 // GetEnvironmentInstanceLabels implements openapi.EnvironmentsAPI.
@@ -24,7 +24,7 @@ type KymaEnvironments struct {
 }
 
 func NewKymaEnvironments(btp btp.Client) *KymaEnvironments {
-	return &KymaEnvironments{btp: btp}
+	return &KymaEnvironments{btp: btp.WithEnvironmentLookup()}
 }
 
 func (c KymaEnvironments) DescribeInstance(
@@ -3,13 +3,13 @@ package kymaenvironmentbinding
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 
 	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
 )
 
@@ -53,7 +53,7 @@ func (c KymaBindings) CreateInstance(ctx context.Context, kymaInstanceId string,
 		CreateEnvironmentInstanceBindingRequest(provisioningclient.CreateEnvironmentInstanceBindingRequest{Parameters: params}).
 		Execute()
 	if err != nil {
-		return nil, errors.Wrap(specifyAPIError(err), errKymaBindingCreateFailed)
+		return nil, errors.Wrap(apierror.New(err, h), errKymaBindingCreateFailed)
 	}
 	marshal, err := json.Marshal(binding)
 	if err != nil {
@@ -98,15 +98,3 @@ type Binding struct {
 	Metadata    *Metadata    `json:"metadata,omitempty"`
 	Credentials *Credentials `json:"credentials,omitempty"`
 }
-
-func specifyAPIError(err error) error {
-	if genericErr, ok := err.(*provisioningclient.GenericOpenAPIError); ok {
-		if specific, ok := genericErr.Model().(provisioningclient.ApiExceptionResponseObject); ok {
-			return fmt.Errorf("API Error: %v, Code %v", specific.Error.Message, specific.Error.Code)
-		}
-		if genericErr.Body() != nil {
-			return fmt.Errorf("API Error: %s", string(genericErr.Body()))
-		}
-	}
-	return err
-}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
@@ -24,7 +25,7 @@ func NewXsuaaRoleCollectionMaintainer(ctx context.Context, clientId, clientSecre
 	apiClientConfig := xsuaa.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddRateLimitedHTTPClientToContext(btp.AddMetricsHTTPClientToContext(ctx, btp.APIXsuaa)))
 
 	roleCollectionApi := xsuaa.NewAPIClient(apiClientConfig).RolecollectionsAPI
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
@@ -27,7 +28,7 @@ func NewXsuaaGroupRoleAssigner(ctx context.Context, clientId, clientSecret, toke
 	apiClientConfig := xsuaa.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddRateLimitedHTTPClientToContext(btp.AddMetricsHTTPClientToContext(ctx, btp.APIXsuaa)))
 
 	groupApi := xsuaa.NewAPIClient(apiClientConfig).IdpRoleCollectionAPI
 
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/sap/crossplane-provider-btp/btp"
 	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
 )
@@ -21,18 +22,20 @@ func NewXsuaaUserRoleAssigner(ctx context.Context, clientId, clientSecret, token
 	apiClientConfig := xsuaa.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddRateLimitedHTTPClientToContext(btp.AddMetricsHTTPClientToContext(ctx, btp.APIXsuaa)))
 
-	userApi := xsuaa.NewAPIClient(apiClientConfig).UsercontrollerAPI
+	apiClient := xsuaa.NewAPIClient(apiClientConfig)
 
 	return &XsusaaUserRoleAssigner{
-		userApi: userApi,
+		userApi:           apiClient.UsercontrollerAPI,
+		roleCollectionApi: apiClient.RolecollectionsAPI,
 	}
 }
 
 // XsusaaUserRoleAssigner manages rolecollection assignments for plain users within XSUAA
 type XsusaaUserRoleAssigner struct {
-	userApi xsuaa.UsercontrollerAPI
+	userApi           xsuaa.UsercontrollerAPI
+	roleCollectionApi xsuaa.RolecollectionsAPI
 }
 
 // HasRole checks if a user has a specific role within XSUAA.
@@ -61,6 +64,21 @@ func (x *XsusaaUserRoleAssigner) RevokeRole(ctx context.Context, origin, usernam
 	return err
 }
 
+// ListUsers returns the names of all users of the given origin, that are assigned to a role collection.
+func (x *XsusaaUserRoleAssigner) ListUsers(ctx context.Context, origin, roleCollection string) ([]string, error) {
+	rc, _, err := x.roleCollectionApi.GetRoleCollectionByName(ctx, roleCollection).WithUsers(true).Execute()
+	if err != nil {
+		return nil, err
+	}
+	users := make([]string, 0, len(rc.UserReferences))
+	for _, user := range rc.UserReferences {
+		if user.Username != nil && user.GetOrigin() == origin {
+			users = append(users, *user.Username)
+		}
+	}
+	return users, nil
+}
+
 // containsRole checks if the user's role collections contain the specified role.
 func containsRole(user *xsuaa.XSUser, roleCollection string) bool {
 	if user.RoleCollections == nil {
@@ -2,6 +2,8 @@ package rolecollectionuserassignment
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -187,3 +189,34 @@ func TestRevokeRole(t *testing.T) {
 		})
 	}
 }
+
+func TestListUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+		case "/sap/rest/authorization/v2/rolecollections/Subaccount Administrator":
+			if r.URL.Query().Get("withUsers") != "true" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"name":"Subaccount Administrator","userReferences":[
+				{"username":"jane.doe@example.com","origin":"sap.default"},
+				{"username":"john.doe@example.com","origin":"custom-idp"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	assigner := NewXsuaaUserRoleAssigner(context.Background(), "client", "secret", server.URL+"/oauth/token", server.URL)
+	users, err := assigner.ListUsers(context.Background(), "sap.default", "Subaccount Administrator")
+
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"jane.doe@example.com"}, users); diff != "" {
+		t.Errorf("ListUsers() -want, +got:\n%s", diff)
+	}
+}
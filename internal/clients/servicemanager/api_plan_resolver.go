@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
@@ -86,6 +87,27 @@ type ServiceManagerClient struct {
 }
 
 func NewServiceManagerClient(ctx context.Context, creds *BindingCredentials) (*ServiceManagerClient, error) {
+	apiClient, err := newApiClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceManagerClient{
+		apiClient.ServiceOfferingsAPI,
+		apiClient.ServicePlansAPI,
+	}, nil
+}
+
+// NewServiceInstancesClient creates a client for the service instances of the subaccount the binding belongs to
+func NewServiceInstancesClient(ctx context.Context, creds *BindingCredentials) (servicemanager.ServiceInstancesAPI, error) {
+	apiClient, err := newApiClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+	return apiClient.ServiceInstancesAPI, nil
+}
+
+func newApiClient(ctx context.Context, creds *BindingCredentials) (*servicemanager.APIClient, error) {
 	const oauthTokenUrlPath = "/oauth/token"
 
 	log := log.FromContext(ctx)
@@ -109,14 +131,9 @@ func NewServiceManagerClient(ctx context.Context, creds *BindingCredentials) (*S
 	apiClientConfig := servicemanager.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
-
-	apiClient := servicemanager.NewAPIClient(apiClientConfig)
+	apiClientConfig.HTTPClient = config.Client(btp.AddRateLimitedHTTPClientToContext(btp.AddMetricsHTTPClientToContext(ctx, btp.APIServiceManager)))
 
-	return &ServiceManagerClient{
-		apiClient.ServiceOfferingsAPI,
-		apiClient.ServicePlansAPI,
-	}, nil
+	return servicemanager.NewAPIClient(apiClientConfig), nil
 }
 
 func (sm *ServiceManagerClient) PlanIDByName(ctx context.Context, offeringName, planName string) (string, error) {
@@ -0,0 +1,126 @@
+// Package subaccountresources lists the environments, subscriptions and service instances of a subaccount,
+// which are deleted along with the subaccount.
+package subaccountresources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+	smclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+const (
+	KindEnvironment     = "environment"
+	KindSubscription    = "subscription"
+	KindServiceInstance = "serviceinstance"
+
+	errCredentialsCorrupted = "cloud management credentials not in the expected format"
+	errNoSaasRegistry       = "cloud management credentials contain no saas registry service url"
+	errListEnvironments     = "cannot list environments"
+	errListSubscriptions    = "cannot list subscriptions"
+	errListInstances        = "cannot list service instances"
+)
+
+// Resource is a resource living in a subaccount
+type Resource struct {
+	Kind string
+	ID   string
+	Name string
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s %s", r.Kind, r.Name)
+}
+
+// Lister lists the resources of a subaccount, service instances are only listed if a service instances client is set
+type Lister struct {
+	environments     provisioningclient.EnvironmentsAPI
+	subscriptions    saas_client.SubscriptionOperationsForAppConsumersAPI
+	serviceInstances smclient.ServiceInstancesAPI
+}
+
+func NewLister(environments provisioningclient.EnvironmentsAPI, subscriptions saas_client.SubscriptionOperationsForAppConsumersAPI, serviceInstances smclient.ServiceInstancesAPI) *Lister {
+	return &Lister{environments: environments, subscriptions: subscriptions, serviceInstances: serviceInstances}
+}
+
+// NewListerFromSecrets creates a lister from the binding of a CloudManagement and optionally the secret of a ServiceManager of the subaccount.
+// Environments and subscriptions are listed with the clients of the binding, so they authenticate like every other CIS client.
+func NewListerFromSecrets(ctx context.Context, user *btp.UserCredential, cisBinding []byte, serviceManagerSecret map[string][]byte) (*Lister, error) {
+	var cisCredential btp.CISCredential
+	if err := json.Unmarshal(cisBinding, &cisCredential); err != nil {
+		return nil, errors.Wrap(err, errCredentialsCorrupted)
+	}
+	btpClient, err := btp.NewServiceClientWithCisCredential(&btp.Credentials{UserCredential: user, CISCredential: &cisCredential})
+	if err != nil {
+		return nil, err
+	}
+	if btpClient.SubscriptionsClient == nil {
+		return nil, errors.New(errNoSaasRegistry)
+	}
+
+	var serviceInstances smclient.ServiceInstancesAPI
+	if serviceManagerSecret != nil {
+		creds, err := servicemanager.NewCredsFromOperatorSecret(serviceManagerSecret)
+		if err != nil {
+			return nil, err
+		}
+		if serviceInstances, err = servicemanager.NewServiceInstancesClient(ctx, &creds); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewLister(btpClient.ProvisioningServiceClient, btpClient.SubscriptionsClient, serviceInstances), nil
+}
+
+// List returns all environments, subscribed applications and service instances of the subaccount
+func (l *Lister) List(ctx context.Context) ([]Resource, error) {
+	var resources []Resource
+
+	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
+	environments, raw, err := l.environments.GetEnvironmentInstances(ctx).Authorization("").Execute()
+	if err != nil {
+		return nil, errors.Wrap(apierror.New(err, raw), errListEnvironments)
+	}
+	for _, env := range environments.EnvironmentInstances {
+		resources = append(resources, Resource{Kind: KindEnvironment, ID: internal.Val(env.Id), Name: internal.Val(env.Name)})
+	}
+
+	applications, raw, err := l.subscriptions.GetEntitledApplications(ctx).Execute()
+	if err != nil {
+		return nil, errors.Wrap(apierror.New(err, raw), errListSubscriptions)
+	}
+	for _, app := range applications.Applications {
+		if state := internal.Val(app.State); state == "" || state == v1alpha1.SubscriptionStateNotSubscribed {
+			continue
+		}
+		resources = append(resources, Resource{Kind: KindSubscription, ID: internal.Val(app.SubscriptionGUID), Name: internal.Val(app.AppName)})
+	}
+
+	if l.serviceInstances == nil {
+		return resources, nil
+	}
+	request := l.serviceInstances.GetAllServiceInstances(ctx)
+	for {
+		instances, _, err := request.Execute()
+		if err != nil {
+			return nil, errors.Wrap(err, errListInstances)
+		}
+		for _, instance := range instances.Items {
+			resources = append(resources, Resource{Kind: KindServiceInstance, ID: internal.Val(instance.Id), Name: internal.Val(instance.Name)})
+		}
+		if internal.Val(instances.Token) == "" {
+			return resources, nil
+		}
+		request = request.Token(*instances.Token)
+	}
+}
@@ -0,0 +1,64 @@
+package subaccountresources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+	smclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+func TestList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/provisioning/v1/environments":
+			_, _ = w.Write([]byte(`{"environmentInstances":[{"id":"env-1","name":"cf-dev"}]}`))
+		case "/saas-manager/v1/applications":
+			_, _ = w.Write([]byte(`{"applications":[{"appName":"app-a","state":"SUBSCRIBED","subscriptionGUID":"sub-1"},{"appName":"app-b","state":"NOT_SUBSCRIBED"}]}`))
+		case "/v1/service_instances":
+			if r.URL.Query().Get("token") == "" {
+				_, _ = w.Write([]byte(`{"items":[{"id":"si-1","name":"xsuaa"}],"token":"next"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[{"id":"si-2","name":"destination"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provisioningConfig := provisioningclient.NewConfiguration()
+	provisioningConfig.Servers = provisioningclient.ServerConfigurations{{URL: server.URL}}
+	saasConfig := saas_client.NewConfiguration()
+	saasConfig.Servers = saas_client.ServerConfigurations{{URL: server.URL}}
+	smURL, _ := url.Parse(server.URL)
+	smConfig := smclient.NewConfiguration()
+	smConfig.Host = smURL.Host
+	smConfig.Scheme = smURL.Scheme
+
+	lister := NewLister(
+		provisioningclient.NewAPIClient(provisioningConfig).EnvironmentsAPI,
+		saas_client.NewAPIClient(saasConfig).SubscriptionOperationsForAppConsumersAPI,
+		smclient.NewAPIClient(smConfig).ServiceInstancesAPI,
+	)
+	got, err := lister.List(context.Background())
+	if err != nil {
+		t.Fatalf("List(...): %v", err)
+	}
+	want := []Resource{
+		{Kind: KindEnvironment, ID: "env-1", Name: "cf-dev"},
+		{Kind: KindSubscription, ID: "sub-1", Name: "app-a"},
+		{Kind: KindServiceInstance, ID: "si-1", Name: "xsuaa"},
+		{Kind: KindServiceInstance, ID: "si-2", Name: "destination"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("List(...): -want, +got:\n%s\n", diff)
+	}
+}
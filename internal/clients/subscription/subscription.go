@@ -2,13 +2,12 @@ package subscription
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
-	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
 	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
 )
@@ -66,7 +65,9 @@ func NewSubscriptionApiHandler(ctx context.Context, clientId string, clientSecre
 	}
 
 	//Set a http client that logs the request and response when running in debug
-	btp.AddDebugPrintHTTPClientToContext(ctx)
+	ctx = btp.AddDebugPrintHTTPClientToContext(ctx)
+	ctx = btp.AddMetricsHTTPClientToContext(ctx, btp.APISaaS)
+	ctx = btp.AddRateLimitedHTTPClientToContext(ctx)
 
 	c.HTTPClient = config.Client(ctx)
 	c.Servers = []saas_client.ServerConfiguration{{URL: serviceUrl}}
@@ -79,11 +80,11 @@ type SubscriptionApiHandler struct {
 }
 
 func (s *SubscriptionApiHandler) CreateSubscription(ctx context.Context, subPost SubscriptionPost) (string, error) {
-	if _, err := s.client.SubscriptionOperationsForAppConsumersAPI.
+	if raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
 		CreateSubscriptionAsync(ctx, subPost.appName).
 		CreateSubscriptionRequestPayload(subPost.CreateSubscriptionRequestPayload).
 		Execute(); err != nil {
-		return "", specifyAPIError(err)
+		return "", apierror.New(err, raw)
 	}
 
 	return formExternalName(subPost.appName, internal.Val(subPost.PlanName)), nil
@@ -92,11 +93,11 @@ func (s *SubscriptionApiHandler) CreateSubscription(ctx context.Context, subPost
 func (s *SubscriptionApiHandler) UpdateSubscription(ctx context.Context, externalName string, subPut SubscriptionPut) error {
 	appName, _ := splitExternalName(externalName)
 
-	if _, err := s.client.SubscriptionOperationsForAppConsumersAPI.
+	if raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
 		UpdateSubscriptionParametersAsync(ctx, appName).
 		UpdateSubscriptionRequestPayload(subPut.UpdateSubscriptionRequestPayload).
 		Execute(); err != nil {
-		return specifyAPIError(err)
+		return apierror.New(err, raw)
 	}
 	return nil
 }
@@ -104,10 +105,10 @@ func (s *SubscriptionApiHandler) UpdateSubscription(ctx context.Context, externa
 func (s *SubscriptionApiHandler) DeleteSubscription(ctx context.Context, externalName string) error {
 	appName, _ := splitExternalName(externalName)
 
-	if _, err := s.client.SubscriptionOperationsForAppConsumersAPI.
+	if raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
 		DeleteSubscriptionAsync(ctx, appName).
 		Execute(); err != nil {
-		return specifyAPIError(err)
+		return apierror.New(err, raw)
 	}
 	return nil
 }
@@ -120,7 +121,7 @@ func (s *SubscriptionApiHandler) GetSubscription(ctx context.Context, externalNa
 		PlanName(planName).
 		Execute()
 	if err != nil {
-		return nil, specifyAPIError(err)
+		return nil, apierror.New(err, raw)
 	}
 
 	// for any reason right now the api actually returns 429 as not found, will
@@ -202,13 +203,3 @@ func splitExternalName(externalName string) (string, string) {
 func formExternalName(appName string, planName string) string {
 	return strings.Join([]string{appName, planName}, "/")
 }
-
-// specifyAPIError brings custom API Error object into a string representation if it can be type asserted, otherwise returns given error
-func specifyAPIError(err error) error {
-	if genericErr, ok := err.(*saas_client.GenericOpenAPIError); ok {
-		if saasErr, ok := genericErr.Model().(saas_client.ErrorResponse); ok {
-			return errors.New(fmt.Sprintf("API Error: %v, Code %v", saasErr.Error.Message, saasErr.Error.Code))
-		}
-	}
-	return err
-}
@@ -0,0 +1,31 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
+)
+
+const (
+	errAdminsCredentials = "cannot read directoryAdminsApiCredentials"
+)
+
+// adminsAccessor connects to the xsuaa api of the directory, it returns nil if admins are only compared with the last assignment
+func (c *external) adminsAccessor(ctx context.Context, cr *v1alpha1.Directory) (admins.Accessor, error) {
+	creds := cr.Spec.ForProvider.DirectoryAdminsApiCredentials
+	if creds == nil {
+		return nil, nil
+	}
+	if c.admins != nil {
+		return c.admins, nil
+	}
+	binding, err := admins.Binding(ctx, c.kube, cr.GetNamespace(), creds.Source, creds.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errAdminsCredentials)
+	}
+	c.admins = c.newAdminsAccessorFn(binding)
+	return c.admins, nil
+}
@@ -6,7 +6,9 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
 	"github.com/sap/crossplane-provider-btp/internal/clients/directory"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
@@ -18,10 +20,11 @@ import (
 
 const (
 	errNotDirectory = "managed resource is not a Directory custom resource"
+	errSaveJob      = "cannot save status of directory creation"
 )
 
-var newDirHandlerFn = func(client *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI {
-	return directory.NewDirectoryClient(client, cr)
+var newDirHandlerFn = func(client *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI {
+	return directory.NewDirectoryClient(client, cr).WithAdmins(adminsFn)
 }
 
 type connector struct {
@@ -29,7 +32,8 @@ type connector struct {
 	usage        resource.Tracker
 	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)
 
-	newDirHandlerFn func(client *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI
+	newDirHandlerFn     func(client *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI
+	newAdminsAccessorFn func(binding *securityv1alpha1.XsuaaBinding) admins.Accessor
 
 	resourcetracker tracking.ReferenceResolverTracker
 }
@@ -46,16 +50,19 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	return &external{
-		kube:            c.kube,
-		btpClient:       btpClient,
-		newDirHandlerFn: c.newDirHandlerFn,
-		tracker:         c.resourcetracker,
+		kube:                c.kube,
+		btpClient:           btpClient,
+		newDirHandlerFn:     c.newDirHandlerFn,
+		newAdminsAccessorFn: c.newAdminsAccessorFn,
+		tracker:             c.resourcetracker,
 	}, nil
 }
 
 type external struct {
-	btpClient       *btp.Client
-	newDirHandlerFn func(client2 *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI
+	btpClient           *btp.Client
+	newDirHandlerFn     func(client2 *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI
+	newAdminsAccessorFn func(binding *securityv1alpha1.XsuaaBinding) admins.Accessor
+	admins              admins.Accessor
 
 	kube    client.Client
 	tracker tracking.ReferenceResolverTracker
@@ -120,6 +127,11 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if clientErr != nil {
 		return managed.ExternalCreation{}, clientErr
 	}
+	if cr.GetNamespace() == "" {
+		if err := providerconfig.SaveCreationStatus(ctx, c.kube, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errSaveJob)
+		}
+	}
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -156,5 +168,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 }
 
 func (c *external) handler(cr *v1alpha1.Directory) directory.DirectoryClientI {
-	return c.newDirHandlerFn(c.btpClient, cr)
+	return c.newDirHandlerFn(c.btpClient, cr, func(ctx context.Context) (admins.Accessor, error) {
+		return c.adminsAccessor(ctx, cr)
+	})
 }
@@ -328,7 +328,7 @@ func TestObserve(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctrl := external{
-				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI {
+				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI {
 					return tc.args.mockClient
 				},
 				tracker: nil,
@@ -409,7 +409,7 @@ func TestCreate(t *testing.T) {
 				return tc.args.kubeUpdateErr
 			}
 			ctrl := external{
-				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI {
+				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI {
 					return tc.args.mockClient
 				},
 				tracker: nil,
@@ -482,7 +482,7 @@ func TestDelete(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			mockKube := testutils.NewFakeKubeClientBuilder().Build()
 			ctrl := external{
-				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI {
+				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI {
 					return tc.args.mockClient
 				},
 				tracker: nil,
@@ -557,7 +557,7 @@ func TestUpdate(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			mockKube := testutils.NewFakeKubeClientBuilder().Build()
 			ctrl := external{
-				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory) directory.DirectoryClientI {
+				newDirHandlerFn: func(client2 *btp.Client, cr *v1alpha1.Directory, adminsFn directory.AdminsAccessorFn) directory.DirectoryClientI {
 					return tc.args.mockClient
 				},
 				tracker: nil,
@@ -12,6 +12,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/namespaced/v1alpha1"
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
@@ -21,7 +22,8 @@ import (
 )
 
 const (
-	errNotEntitlement = "managed resource is not a Entitlement custom resource"
+	errNotEntitlement             = "managed resource is not a Entitlement custom resource"
+	errSubaccountOutsideNamespace = "namespaced entitlement can only assign quota to a subaccount managed by a Subaccount of namespace %s"
 )
 
 var (
@@ -79,6 +81,9 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	err := c.updateObservation(ctx, cr)
 	cr.SetConditions(c.softValidation(cr))
+	if err == nil && cr.Status.AtProvider.Quota != nil {
+		cr.SetConditions(entitlementclient.QuotaCondition(cr))
+	}
 	c.tracker.SetConditions(ctx, cr)
 	if err != nil {
 		return managed.ExternalObservation{}, err
@@ -144,6 +149,18 @@ func (c *external) updateObservation(ctx context.Context, cr *apisv1alpha1.Entit
 	if err != nil {
 		return err
 	}
+	if !entitlementclient.HasPlanQuota(cr) {
+		return nil
+	}
+	pool, err := c.client.QuotaPool(ctx, cr)
+	if err != nil {
+		return err
+	}
+	planEntitlements, err := c.findPlanEntitlements(ctx, cr)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.Quota = entitlementclient.PlanQuota(cr, pool, planEntitlements)
 	return nil
 }
 
@@ -153,12 +170,20 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotEntitlement)
 	}
 
+	if err := c.checkNamespace(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	err := c.updateObservation(ctx, cr)
 
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
+	if err := entitlementclient.ValidateQuota(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	if err := c.client.CreateInstance(ctx, cr); err != nil {
 		return managed.ExternalCreation{}, err
 	}
@@ -184,7 +209,20 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	if err := c.client.UpdateInstance(ctx, cr); err != nil {
+	if err := c.checkNamespace(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := entitlementclient.ValidateQuota(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	pending, err := c.pendingAssignments(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := c.client.UpdateInstance(ctx, cr, pending...); err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 	fmt.Printf("Updating: %+v", cr)
@@ -276,10 +314,7 @@ func (c *external) findRelatedEntitlements(
 	ours *apisv1alpha1.Entitlement,
 	isRelevant func(entitlement apisv1alpha1.Entitlement) bool,
 ) (*apisv1alpha1.EntitlementList, error) {
-	allEntitlements := &apisv1alpha1.EntitlementList{}
-	// client.MatchingLabels()
-	err := c.kube.List(ctx, allEntitlements)
-
+	allEntitlements, err := c.listEntitlements(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +340,154 @@ func (c *external) findRelatedEntitlements(
 	return relatedEntitlements, nil
 }
 
+// pendingAssignments resolves the outdated assignments of the other plans of the subaccount, one entitlement per plan, to
+// send them along with the update of ours. The batch of the client only collects reconciles running at the same time,
+// which are limited by the concurrency of the controller, while entitlements of a subaccount are usually changed together.
+// A plan is only pending if its last observation already required the current amount, passed the quota check and isn't in progress.
+func (c *external) pendingAssignments(ctx context.Context, ours *apisv1alpha1.Entitlement) ([]*apisv1alpha1.Entitlement, error) {
+	allEntitlements, err := c.listEntitlements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type plan struct{ service, name string }
+	var plans []plan
+	related := map[plan]*apisv1alpha1.EntitlementList{}
+	for _, ent := range allEntitlements.Items {
+		if ent.Spec.ForProvider.SubaccountGuid != ours.Spec.ForProvider.SubaccountGuid {
+			continue
+		}
+		p := plan{service: ent.Spec.ForProvider.ServiceName, name: ent.Spec.ForProvider.ServicePlanName}
+		if p == (plan{service: ours.Spec.ForProvider.ServiceName, name: ours.Spec.ForProvider.ServicePlanName}) {
+			continue
+		}
+		if ent.GetCondition(xpv1.Deleting().Type).Reason == xpv1.Deleting().Reason {
+			continue
+		}
+		if _, ok := related[p]; !ok {
+			plans = append(plans, p)
+			related[p] = &apisv1alpha1.EntitlementList{}
+		}
+		related[p].Items = append(related[p].Items, ent)
+	}
+
+	var pending []*apisv1alpha1.Entitlement
+	for _, p := range plans {
+		if ent := c.pendingAssignment(related[p]); ent != nil {
+			pending = append(pending, ent)
+		}
+	}
+	return pending, nil
+}
+
+// pendingAssignment returns an entitlement of the plan if its assignment is outdated, nil otherwise
+func (c *external) pendingAssignment(related *apisv1alpha1.EntitlementList) *apisv1alpha1.Entitlement {
+	required, err := entitlementclient.MergeRelatedEntitlements(related)
+	if err != nil || required.Amount == nil {
+		return nil
+	}
+	for i := range related.Items {
+		ent := &related.Items[i]
+		if ent.Status.AtProvider == nil || ent.Status.AtProvider.Required == nil || ent.Status.AtProvider.Assigned == nil {
+			continue
+		}
+		if !reflect.DeepEqual(required.Amount, ent.Status.AtProvider.Required.Amount) {
+			continue
+		}
+		if ent.GetCondition(apisv1alpha1.QuotaCondition).Reason == apisv1alpha1.QuotaExceededReason {
+			return nil
+		}
+		if c.updateInProgress(ent) || !c.needsUpdate(ent) {
+			return nil
+		}
+		return ent
+	}
+	return nil
+}
+
+// findPlanEntitlements resolves the entitlements of all subaccounts for the same service and plan, they share the quota of the plan
+func (c *external) findPlanEntitlements(ctx context.Context, ours *apisv1alpha1.Entitlement) (*apisv1alpha1.EntitlementList, error) {
+	allEntitlements, err := c.listEntitlements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	planEntitlements := &apisv1alpha1.EntitlementList{}
+	for _, ent := range allEntitlements.Items {
+		if ent.Spec.ForProvider.ServiceName != ours.Spec.ForProvider.ServiceName {
+			continue
+		}
+		if ent.Spec.ForProvider.ServicePlanName != ours.Spec.ForProvider.ServicePlanName {
+			continue
+		}
+		if ent.GetCondition(xpv1.Deleting().Type).Reason == xpv1.Deleting().Reason {
+			continue
+		}
+		planEntitlements.Items = append(planEntitlements.Items, ent)
+	}
+	return planEntitlements, nil
+}
+
+// listEntitlements lists the cluster scoped entitlements and the namespaced entitlements of subaccounts managed in their own namespace.
+// BTP keeps one assignment per subaccount and plan, so entitlements of both kinds add up to one total instead of overwriting each
+// other's amount. Namespaced entitlements of other subaccounts are rejected by checkNamespace and don't count.
+func (c *external) listEntitlements(ctx context.Context) (*apisv1alpha1.EntitlementList, error) {
+	allEntitlements := &apisv1alpha1.EntitlementList{}
+	if err := c.kube.List(ctx, allEntitlements); err != nil {
+		return nil, err
+	}
+
+	namespaced := &namespacedv1alpha1.EntitlementList{}
+	if err := c.kube.List(ctx, namespaced); err != nil {
+		return nil, err
+	}
+	if len(namespaced.Items) == 0 {
+		return allEntitlements, nil
+	}
+	owners, err := c.subaccountNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range namespaced.Items {
+		if owners[namespaced.Items[i].Spec.ForProvider.SubaccountGuid] != namespaced.Items[i].GetNamespace() {
+			continue
+		}
+		if ent, ok := namespaced.Items[i].ClusterScoped().(*apisv1alpha1.Entitlement); ok {
+			allEntitlements.Items = append(allEntitlements.Items, *ent)
+		}
+	}
+	return allEntitlements, nil
+}
+
+// subaccountNamespaces maps the GUIDs of the subaccounts managed by namespaced Subaccounts to their namespace
+func (c *external) subaccountNamespaces(ctx context.Context) (map[string]string, error) {
+	subaccounts := &namespacedv1alpha1.SubaccountList{}
+	if err := c.kube.List(ctx, subaccounts); err != nil {
+		return nil, err
+	}
+	owners := make(map[string]string, len(subaccounts.Items))
+	for _, sa := range subaccounts.Items {
+		if sa.Status.AtProvider.SubaccountGuid != nil {
+			owners[*sa.Status.AtProvider.SubaccountGuid] = sa.GetNamespace()
+		}
+	}
+	return owners, nil
+}
+
+// checkNamespace prevents a namespace from changing the assignments of subaccounts managed elsewhere, as all
+// entitlements of a subaccount and plan add up to one total.
+func (c *external) checkNamespace(ctx context.Context, cr *apisv1alpha1.Entitlement) error {
+	if cr.GetNamespace() == "" {
+		return nil
+	}
+	owners, err := c.subaccountNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	if owners[cr.Spec.ForProvider.SubaccountGuid] != cr.GetNamespace() {
+		return errors.Errorf(errSubaccountOutsideNamespace, cr.GetNamespace())
+	}
+	return nil
+}
+
 // softValidation adds conditions to the CR in order to guide the user with the usage of the Entitlements.
 func (c *external) softValidation(cr *apisv1alpha1.Entitlement) xpv1.Condition {
 	var errs []string
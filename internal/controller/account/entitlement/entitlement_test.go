@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/namespaced/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
 	entitlement2 "github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
@@ -448,12 +449,24 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+// ListEntitlements lists the given entitlements, those with a namespace as namespaced entitlements
 func ListEntitlements(v ...*v1alpha1.Entitlement) test.ObjectListFn {
 	return func(obj client.ObjectList) error {
-		l := obj.(*v1alpha1.EntitlementList)
-		l.Items = []v1alpha1.Entitlement{}
-		for _, e := range v {
-			l.Items = append(l.Items, *e)
+		switch l := obj.(type) {
+		case *v1alpha1.EntitlementList:
+			l.Items = []v1alpha1.Entitlement{}
+			for _, e := range v {
+				if e.GetNamespace() == "" {
+					l.Items = append(l.Items, *e)
+				}
+			}
+		case *namespacedv1alpha1.EntitlementList:
+			l.Items = []namespacedv1alpha1.Entitlement{}
+			for _, e := range v {
+				if e.GetNamespace() != "" {
+					l.Items = append(l.Items, namespacedv1alpha1.Entitlement{ObjectMeta: e.ObjectMeta, Spec: e.Spec, Status: e.Status})
+				}
+			}
 		}
 		return nil
 	}
@@ -487,6 +500,9 @@ func withSubaccountGuid(guid string) entitlementModifier {
 	return func(r *v1alpha1.Entitlement) { r.Spec.ForProvider.SubaccountGuid = guid }
 }
 
+func withNamespace(namespace string) entitlementModifier {
+	return func(r *v1alpha1.Entitlement) { r.Namespace = namespace }
+}
 func withAmount(amount int) entitlementModifier {
 	return func(r *v1alpha1.Entitlement) { r.Spec.ForProvider.Amount = &amount }
 }
@@ -500,6 +516,15 @@ func withConditions(c ...xpv1.Condition) entitlementModifier {
 		r.Status.SetConditions(c...)
 	}
 }
+func withObservation(required, assigned int, state string) entitlementModifier {
+	return func(r *v1alpha1.Entitlement) {
+		r.Status.AtProvider = &v1alpha1.EntitlementObservation{
+			Required: &v1alpha1.EntitlementSummary{Amount: &required},
+			Assigned: &v1alpha1.Assignable{Amount: &assigned, EntityState: state},
+		}
+	}
+}
+
 func entitlement(m ...entitlementModifier) *v1alpha1.Entitlement {
 	cr := &v1alpha1.Entitlement{
 		ObjectMeta: metav1.ObjectMeta{
@@ -512,6 +537,53 @@ func entitlement(m ...entitlementModifier) *v1alpha1.Entitlement {
 	return cr
 }
 
+// listWithSubaccounts lists the given entitlements and a namespaced Subaccount managing subaccountGuid in namespace
+func listWithSubaccounts(namespace string, subaccountGuid string, v ...*v1alpha1.Entitlement) test.ObjectListFn {
+	entitlements := ListEntitlements(v...)
+	return func(obj client.ObjectList) error {
+		if l, ok := obj.(*namespacedv1alpha1.SubaccountList); ok {
+			sa := namespacedv1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "subaccount", Namespace: namespace}}
+			sa.Status.AtProvider.SubaccountGuid = &subaccountGuid
+			l.Items = []namespacedv1alpha1.Subaccount{sa}
+			return nil
+		}
+		return entitlements(obj)
+	}
+}
+
+func TestObserveSumsClusterScopedAndNamespaced(t *testing.T) {
+	clusterScoped := entitlement(withAmount(1), withSubaccountGuid("sa-guid"))
+	namespaced := entitlement(withName("team-entitlement"), withNamespace("team-a"), withAmount(2), withSubaccountGuid("sa-guid"))
+	otherNamespace := entitlement(withName("foreign-entitlement"), withNamespace("team-b"), withAmount(4), withSubaccountGuid("sa-guid"))
+	kube := &test.MockClient{MockStatusUpdate: noopStatusUpdate, MockList: test.NewMockListFn(nil, listWithSubaccounts("team-a", "sa-guid", clusterScoped, namespaced, otherNamespace))}
+	describe := func(ctx context.Context, input v1alpha1.Entitlement) (*entitlement2.Instance, error) {
+		return &entitlement2.Instance{EntitledServicePlan: &entclient.ServicePlanResponseObject{}}, nil
+	}
+
+	for _, cr := range []*v1alpha1.Entitlement{clusterScoped.DeepCopy(), namespaced.DeepCopy()} {
+		e := external{kube: kube, client: fake.MockClient{MockDescribeCluster: describe}, tracker: test2.NoOpReferenceResolverTracker{}}
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("e.Observe(...): %v", err)
+		}
+		if diff := cmp.Diff(internal.Ptr(3), cr.Status.AtProvider.Required.Amount); diff != "" {
+			t.Errorf("e.Observe(%s): cluster scoped and namespaced entitlements of a subaccount and plan add up to one total, entitlements of other namespaces don't count, -want, +got:\n%s\n", cr.GetName(), diff)
+		}
+	}
+}
+
+func TestNamespacedEntitlementOfOtherNamespace(t *testing.T) {
+	cr := entitlement(withName("foreign-entitlement"), withNamespace("team-b"), withAmount(4), withSubaccountGuid("sa-guid"))
+	kube := &test.MockClient{MockList: test.NewMockListFn(nil, listWithSubaccounts("team-a", "sa-guid", cr))}
+	e := external{kube: kube, client: fake.MockClient{}, tracker: test2.NoOpReferenceResolverTracker{}}
+
+	_, err := e.Create(context.Background(), cr)
+
+	want := "namespaced entitlement can only assign quota to a subaccount managed by a Subaccount of namespace team-b"
+	if err == nil || err.Error() != want {
+		t.Errorf("e.Create(...): want error %q, got %v", want, err)
+	}
+}
+
 func TestObserveWithDifferentType(t *testing.T) {
 	type args struct {
 		cr     resource.Managed
@@ -636,3 +708,109 @@ func TestObserveSoftvalidation(t *testing.T) {
 		)
 	}
 }
+
+func TestCreateQuotaPrecheck(t *testing.T) {
+	type want struct {
+		called    bool
+		condition xpv1.ConditionReason
+		err       bool
+	}
+	var cases = map[string]struct {
+		reason    string
+		remaining float32
+		related   []*v1alpha1.Entitlement
+		want      want
+	}{
+		"QuotaAvailable": {
+			reason:    "Assignments within the remaining quota are sent to the API",
+			remaining: 3,
+			related:   []*v1alpha1.Entitlement{entitlement(withServiceName("hana-cloud"), withServicePlan("hana"), withSubaccountGuid("a"), withAmount(3))},
+			want:      want{called: true, condition: v1alpha1.QuotaAvailableReason},
+		},
+		"QuotaOvercommitted": {
+			reason:    "Assignments of other subaccounts competing for the remaining quota are reported, but not refused",
+			remaining: 3,
+			related: []*v1alpha1.Entitlement{
+				entitlement(withServiceName("hana-cloud"), withServicePlan("hana"), withSubaccountGuid("a"), withAmount(3)),
+				entitlement(withName("other"), withServiceName("hana-cloud"), withServicePlan("hana"), withSubaccountGuid("b"), withAmount(1)),
+			},
+			want: want{called: true, condition: v1alpha1.QuotaOvercommittedReason},
+		},
+		"QuotaExceeded": {
+			reason:    "Assignments exceeding the remaining quota are refused before calling the API",
+			remaining: 2,
+			related:   []*v1alpha1.Entitlement{entitlement(withServiceName("hana-cloud"), withServicePlan("hana"), withSubaccountGuid("a"), withAmount(3))},
+			want:      want{condition: v1alpha1.QuotaExceededReason, err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			e := external{
+				kube: &test.MockClient{MockList: test.NewMockListFn(nil, ListEntitlements(tc.related...))},
+				client: fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input v1alpha1.Entitlement) (*entitlement2.Instance, error) {
+						return &entitlement2.Instance{EntitledServicePlan: &entclient.ServicePlanResponseObject{
+							Name:            internal.Ptr("hana"),
+							Amount:          internal.Ptr(float32(10)),
+							RemainingAmount: internal.Ptr(tc.remaining),
+						}}, nil
+					},
+					MockUpdateInstance: func(ctx context.Context, cr *v1alpha1.Entitlement, pending ...*v1alpha1.Entitlement) error {
+						called = true
+						return nil
+					},
+				},
+				tracker: test2.NoOpReferenceResolverTracker{},
+			}
+			cr := entitlement(withServiceName("hana-cloud"), withServicePlan("hana"), withSubaccountGuid("a"), withAmount(3))
+			_, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v", tc.reason, err)
+			}
+			_, err = e.Create(context.Background(), cr)
+			if (err != nil) != tc.want.err {
+				t.Errorf("\n%s\ne.Create(...): want error %t, got %v", tc.reason, tc.want.err, err)
+			}
+			if called != tc.want.called {
+				t.Errorf("\n%s\ne.Create(...): want API called %t, got %t", tc.reason, tc.want.called, called)
+			}
+			if got := cr.GetCondition(v1alpha1.QuotaCondition).Reason; got != tc.want.condition {
+				t.Errorf("\n%s\ne.Observe(...): want quota condition %s, got %s", tc.reason, tc.want.condition, got)
+			}
+		})
+	}
+}
+
+func TestUpdatePendingAssignments(t *testing.T) {
+	ours := entitlement(withServiceName("hana-cloud"), withServicePlan("hana"), withSubaccountGuid("a"), withAmount(2), withObservation(2, 1, v1alpha1.EntitlementStatusOk))
+	others := []*v1alpha1.Entitlement{
+		entitlement(withName("pending"), withServiceName("hana-cloud"), withServicePlan("tools"), withSubaccountGuid("a"), withAmount(2), withObservation(2, 1, v1alpha1.EntitlementStatusOk)),
+		entitlement(withName("in-sync"), withServiceName("hana-cloud"), withServicePlan("relational-data-lake"), withSubaccountGuid("a"), withAmount(1), withObservation(1, 1, v1alpha1.EntitlementStatusOk)),
+		entitlement(withName("changed-since-observe"), withServiceName("auditlog"), withServicePlan("standard"), withSubaccountGuid("a"), withAmount(3), withObservation(2, 1, v1alpha1.EntitlementStatusOk)),
+		entitlement(withName("processing"), withServiceName("auditlog"), withServicePlan("premium"), withSubaccountGuid("a"), withAmount(2), withObservation(2, 1, v1alpha1.EntitlementStatusProcessing)),
+		entitlement(withName("exceeded"), withServiceName("destination"), withServicePlan("lite"), withSubaccountGuid("a"), withAmount(2), withObservation(2, 1, v1alpha1.EntitlementStatusOk), withConditions(v1alpha1.QuotaExceeded("exceeded"))),
+		entitlement(withName("not-observed"), withServiceName("destination"), withServicePlan("standard"), withSubaccountGuid("a"), withAmount(2)),
+		entitlement(withName("other-subaccount"), withServiceName("hana-cloud"), withServicePlan("tools"), withSubaccountGuid("b"), withAmount(2), withObservation(2, 1, v1alpha1.EntitlementStatusOk)),
+	}
+	var got []string
+	e := external{
+		kube: &test.MockClient{MockList: test.NewMockListFn(nil, ListEntitlements(append(others, ours)...))},
+		client: fake.MockClient{
+			MockUpdateInstance: func(ctx context.Context, cr *v1alpha1.Entitlement, pending ...*v1alpha1.Entitlement) error {
+				for _, p := range pending {
+					got = append(got, p.GetName())
+				}
+				return nil
+			},
+		},
+		tracker: test2.NoOpReferenceResolverTracker{},
+	}
+
+	if _, err := e.Update(context.Background(), ours); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"pending"}, got); diff != "" {
+		t.Errorf("e.Update(...): only outdated assignments of other plans of the subaccount must be sent along, -want, +got:\n%s\n", diff)
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 type MockClient struct {
 	MockDescribeCluster func(ctx context.Context, input apisv1alpha1.Entitlement) (*entitlement.Instance, error)
+	MockUpdateInstance  func(ctx context.Context, cr *apisv1alpha1.Entitlement, pending ...*apisv1alpha1.Entitlement) error
+	MockQuotaPool       func(ctx context.Context, cr *apisv1alpha1.Entitlement) (string, error)
 }
 
 func (c MockClient) DescribeInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) (
@@ -18,11 +20,20 @@ func (c MockClient) DescribeInstance(ctx context.Context, cr *apisv1alpha1.Entit
 	return c.MockDescribeCluster(ctx, *cr)
 }
 func (c MockClient) CreateInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) error {
-	return nil
+	return c.UpdateInstance(ctx, cr)
 }
-func (c MockClient) UpdateInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) error {
+func (c MockClient) UpdateInstance(ctx context.Context, cr *apisv1alpha1.Entitlement, pending ...*apisv1alpha1.Entitlement) error {
+	if c.MockUpdateInstance != nil {
+		return c.MockUpdateInstance(ctx, cr, pending...)
+	}
 	return nil
 }
 func (c MockClient) DeleteInstance(ctx context.Context, cr *apisv1alpha1.Entitlement) error {
 	return nil
 }
+func (c MockClient) QuotaPool(ctx context.Context, cr *apisv1alpha1.Entitlement) (string, error) {
+	if c.MockQuotaPool != nil {
+		return c.MockQuotaPool(ctx, cr)
+	}
+	return "", nil
+}
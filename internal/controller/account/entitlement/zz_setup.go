@@ -7,8 +7,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/namespaced/v1alpha1"
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
-	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
@@ -16,15 +16,19 @@ import (
 
 // Setup adds a controller that reconciles Entitlement managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	return providerconfig.DefaultSetup(mgr, o, &apisv1alpha1.Entitlement{}, apisv1alpha1.EntitlementGroupKind, apisv1alpha1.EntitlementGroupVersionKind, func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
-		return &connector{
-			kube: mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(
-				mgr.GetClient(),
-				&providerv1alpha1.ProviderConfigUsage{},
-			),
-			resourcetracker: resourcetracker,
-			newServiceFn:    btp.NewBTPClient,
-		}
-	})
+	return providerconfig.DefaultSetup(mgr, o, &apisv1alpha1.Entitlement{}, apisv1alpha1.EntitlementGroupKind, apisv1alpha1.EntitlementGroupVersionKind, newConnector)
+}
+
+// SetupNamespaced adds a controller that reconciles namespaced Entitlement managed resources.
+func SetupNamespaced(mgr ctrl.Manager, o controller.Options) error {
+	return providerconfig.NamespacedSetup(mgr, o, &namespacedv1alpha1.Entitlement{}, namespacedv1alpha1.EntitlementGroupKind, namespacedv1alpha1.EntitlementGroupVersionKind, newConnector)
+}
+
+func newConnector(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
+	return &connector{
+		kube:            kube,
+		usage:           usage,
+		newServiceFn:    newServiceFn,
+		resourcetracker: resourcetracker,
+	}
 }
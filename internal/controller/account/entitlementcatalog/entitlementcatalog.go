@@ -0,0 +1,205 @@
+package entitlementcatalog
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotEntitlementCatalog = "managed resource is not an EntitlementCatalog custom resource"
+	errGetEntitlements       = "Get entitlements of global account request failed."
+	errGetDataCenters        = "Get data centers of global account request failed."
+
+	// defaultSyncInterval applies if the spec has not been defaulted by the API server
+	defaultSyncInterval = 10 * time.Minute
+)
+
+// catalogAPI reads the entitlements and regions of the global account
+type catalogAPI interface {
+	EntitledServices(ctx context.Context) (*entclient.EntitledAndAssignedServicesResponseObject, error)
+	DataCenters(ctx context.Context) (*entclient.DataCenterResponseCollection, error)
+}
+
+type btpCatalogAPI struct {
+	btp btp.Client
+}
+
+// EntitledServices returns the entitlements of the global account, as no directory or subaccount is given
+func (a btpCatalogAPI) EntitledServices(ctx context.Context) (*entclient.EntitledAndAssignedServicesResponseObject, error) {
+	response, raw, err := a.btp.EntitlementsServiceClient.GetDirectoryAssignments(ctx).Execute()
+	return response, apierror.New(err, raw)
+}
+
+func (a btpCatalogAPI) DataCenters(ctx context.Context) (*entclient.DataCenterResponseCollection, error) {
+	response, raw, err := a.btp.RegionsServiceClient.GetAllowedDataCenters(ctx).Execute()
+	return response, apierror.New(err, raw)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+	newServiceFn    func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)
+}
+
+// Connect produces an ExternalClient reading the catalog with the credentials of the ProviderConfig.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*apisv1alpha1.EntitlementCatalog)
+	if !ok {
+		return nil, errors.New(errNotEntitlementCatalog)
+	}
+
+	btpclient, err := providerconfig.CreateClient(ctx, mg, c.kube, c.usage, c.newServiceFn, c.resourcetracker)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{api: btpCatalogAPI{btp: *btpclient}, now: time.Now}, nil
+}
+
+// An external syncs the catalog into the status. The catalog is read-only,
+// it always exists and is up to date, so Create, Update and Delete do nothing.
+type external struct {
+	api catalogAPI
+	now func() time.Time
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*apisv1alpha1.EntitlementCatalog)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEntitlementCatalog)
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if !needsSync(cr, c.now()) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	entitlements, err := c.api.EntitledServices(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEntitlements)
+	}
+	dataCenters, err := c.api.DataCenters(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDataCenters)
+	}
+
+	cr.Status.AtProvider = apisv1alpha1.EntitlementCatalogObservation{
+		Services:           catalogServices(entitlements, cr.Spec.ForProvider.ServiceNames),
+		Regions:            entitlement.Regions(dataCenters),
+		LastSyncTime:       &metav1.Time{Time: c.now()},
+		ObservedGeneration: cr.GetGeneration(),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// needsSync is true if the spec changed since the last sync or the sync interval has passed
+func needsSync(cr *apisv1alpha1.EntitlementCatalog, now time.Time) bool {
+	obs := cr.Status.AtProvider
+	if obs.LastSyncTime == nil || obs.ObservedGeneration != cr.GetGeneration() {
+		return true
+	}
+	interval := defaultSyncInterval
+	if cr.Spec.ForProvider.SyncInterval != nil {
+		interval = cr.Spec.ForProvider.SyncInterval.Duration
+	}
+	return !now.Before(obs.LastSyncTime.Add(interval))
+}
+
+// catalogServices maps the entitled services sorted by name, restricted to the given service names if any
+func catalogServices(entitlements *entclient.EntitledAndAssignedServicesResponseObject, serviceNames []string) []apisv1alpha1.CatalogService {
+	services := []apisv1alpha1.CatalogService{}
+	for _, s := range entitlements.EntitledServices {
+		name := internal.Val(s.Name)
+		if len(serviceNames) > 0 && !slices.Contains(serviceNames, name) {
+			continue
+		}
+		service := apisv1alpha1.CatalogService{
+			Name:        name,
+			DisplayName: internal.Val(s.DisplayName),
+			Plans:       []apisv1alpha1.CatalogPlan{},
+		}
+		if s.BusinessCategory != nil {
+			service.BusinessCategory = internal.Val(s.BusinessCategory.Id)
+		}
+		for _, p := range s.ServicePlans {
+			service.Plans = append(service.Plans, catalogPlan(p))
+		}
+		slices.SortFunc(service.Plans, func(a, b apisv1alpha1.CatalogPlan) int {
+			if c := strings.Compare(a.Name, b.Name); c != 0 {
+				return c
+			}
+			return strings.Compare(a.UniqueIdentifier, b.UniqueIdentifier)
+		})
+		services = append(services, service)
+	}
+	slices.SortFunc(services, func(a, b apisv1alpha1.CatalogService) int { return strings.Compare(a.Name, b.Name) })
+	return services
+}
+
+func catalogPlan(p entclient.ServicePlanResponseObject) apisv1alpha1.CatalogPlan {
+	plan := apisv1alpha1.CatalogPlan{
+		Name:                      internal.Val(p.Name),
+		DisplayName:               internal.Val(p.DisplayName),
+		UniqueIdentifier:          internal.Val(p.UniqueIdentifier),
+		Category:                  internal.Val(p.Category),
+		Beta:                      internal.Val(p.Beta),
+		Unlimited:                 internal.Val(p.Unlimited),
+		Amount:                    int(internal.Val(p.Amount)),
+		RemainingAmount:           int(internal.Val(p.RemainingAmount)),
+		MaxAllowedSubaccountQuota: int(internal.Val(p.MaxAllowedSubaccountQuota)),
+	}
+	for _, dc := range p.DataCenters {
+		if region := internal.Val(dc.Region); region != "" && !slices.Contains(plan.Regions, region) {
+			plan.Regions = append(plan.Regions, region)
+		}
+	}
+	slices.Sort(plan.Regions)
+	return plan
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*apisv1alpha1.EntitlementCatalog)
+	if !ok {
+		return errors.New(errNotEntitlementCatalog)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
@@ -0,0 +1,207 @@
+package entitlementcatalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+var syncTime = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type mockCatalogAPI struct {
+	entitlements *entclient.EntitledAndAssignedServicesResponseObject
+	dataCenters  *entclient.DataCenterResponseCollection
+	err          error
+	calls        *int
+}
+
+func (m mockCatalogAPI) EntitledServices(ctx context.Context) (*entclient.EntitledAndAssignedServicesResponseObject, error) {
+	*m.calls++
+	return m.entitlements, m.err
+}
+
+func (m mockCatalogAPI) DataCenters(ctx context.Context) (*entclient.DataCenterResponseCollection, error) {
+	return m.dataCenters, nil
+}
+
+func globalAccountEntitlements() *entclient.EntitledAndAssignedServicesResponseObject {
+	return &entclient.EntitledAndAssignedServicesResponseObject{
+		EntitledServices: []entclient.EntitledServicesResponseObject{
+			{
+				Name:             internal.Ptr("postgresql-db"),
+				DisplayName:      internal.Ptr("PostgreSQL, Hyperscaler Option"),
+				BusinessCategory: &entclient.BusinessCategoryResponseObject{Id: internal.Ptr("FOUNDATION_CROSS_SERVICES")},
+				ServicePlans: []entclient.ServicePlanResponseObject{
+					{
+						Name:             internal.Ptr("storage"),
+						UniqueIdentifier: internal.Ptr("postgresql-db-storage"),
+						Category:         internal.Ptr("SERVICE"),
+						Amount:           internal.Ptr(float32(100)),
+						RemainingAmount:  internal.Ptr(float32(40)),
+					},
+					{
+						Name:             internal.Ptr("development"),
+						UniqueIdentifier: internal.Ptr("postgresql-db-development"),
+						Category:         internal.Ptr("SERVICE"),
+						Beta:             internal.Ptr(true),
+						Amount:           internal.Ptr(float32(5)),
+						RemainingAmount:  internal.Ptr(float32(5)),
+						DataCenters: []entclient.DataCenterResponseObject{
+							{Name: internal.Ptr("cf-us10"), Region: internal.Ptr("us10")},
+							{Name: internal.Ptr("cf-eu10"), Region: internal.Ptr("eu10")},
+							{Name: internal.Ptr("kyma-eu10"), Region: internal.Ptr("eu10")},
+						},
+					},
+				},
+			},
+			{
+				Name: internal.Ptr("auditlog-viewer"),
+				ServicePlans: []entclient.ServicePlanResponseObject{
+					{Name: internal.Ptr("free"), Category: internal.Ptr("APPLICATION"), Unlimited: internal.Ptr(true)},
+				},
+			},
+		},
+	}
+}
+
+func globalAccountDataCenters() *entclient.DataCenterResponseCollection {
+	return &entclient.DataCenterResponseCollection{
+		Datacenters: []entclient.DataCenterResponseObject{
+			{Name: internal.Ptr("cf-us10"), Region: internal.Ptr("us10"), Environment: internal.Ptr("cloudfoundry"), IaasProvider: internal.Ptr("AWS"), SupportsTrial: internal.Ptr(true)},
+			{Name: internal.Ptr("cf-eu10"), Region: internal.Ptr("eu10"), Environment: internal.Ptr("cloudfoundry"), IaasProvider: internal.Ptr("AWS")},
+		},
+	}
+}
+
+func newCatalog(params apisv1alpha1.EntitlementCatalogParameters, lastSync *time.Time) *apisv1alpha1.EntitlementCatalog {
+	cr := &apisv1alpha1.EntitlementCatalog{Spec: apisv1alpha1.EntitlementCatalogSpec{ForProvider: params}}
+	cr.SetGeneration(1)
+	if lastSync != nil {
+		cr.Status.AtProvider.LastSyncTime = &metav1.Time{Time: *lastSync}
+		cr.Status.AtProvider.ObservedGeneration = 1
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	recentSync := syncTime.Add(-5 * time.Minute)
+	staleSync := syncTime.Add(-time.Hour)
+	errBoom := errors.New("boom")
+
+	type want struct {
+		o     managed.ExternalObservation
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *apisv1alpha1.EntitlementCatalog
+		apiErr error
+		want   want
+	}{
+		"FirstSync": {
+			reason: "A catalog that has never been synced is synced",
+			cr:     newCatalog(apisv1alpha1.EntitlementCatalogParameters{}, nil),
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, calls: 1},
+		},
+		"WithinInterval": {
+			reason: "A catalog synced within the sync interval is not synced again",
+			cr:     newCatalog(apisv1alpha1.EntitlementCatalogParameters{}, &recentSync),
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"IntervalPassed": {
+			reason: "A catalog is synced again once the sync interval has passed",
+			cr:     newCatalog(apisv1alpha1.EntitlementCatalogParameters{SyncInterval: &metav1.Duration{Duration: time.Minute}}, &recentSync),
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, calls: 1},
+		},
+		"Stale": {
+			reason: "A catalog synced before the default interval is synced again",
+			cr:     newCatalog(apisv1alpha1.EntitlementCatalogParameters{}, &staleSync),
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, calls: 1},
+		},
+		"SpecChanged": {
+			reason: "A changed spec is synced right away",
+			cr: func() *apisv1alpha1.EntitlementCatalog {
+				cr := newCatalog(apisv1alpha1.EntitlementCatalogParameters{ServiceNames: []string{"postgresql-db"}}, &recentSync)
+				cr.SetGeneration(2)
+				return cr
+			}(),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, calls: 1},
+		},
+		"Error": {
+			reason: "Errors reading the entitlements are returned",
+			cr:     newCatalog(apisv1alpha1.EntitlementCatalogParameters{}, nil),
+			apiErr: errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetEntitlements), calls: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			e := external{
+				api: mockCatalogAPI{entitlements: globalAccountEntitlements(), dataCenters: globalAccountDataCenters(), err: tc.apiErr, calls: &calls},
+				now: func() time.Time { return syncTime },
+			}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if calls != tc.want.calls {
+				t.Errorf("\n%s\ne.Observe(...): want %d entitlement requests, got %d", tc.reason, tc.want.calls, calls)
+			}
+		})
+	}
+}
+
+func TestCatalogServices(t *testing.T) {
+	want := []apisv1alpha1.CatalogService{
+		{
+			Name:  "auditlog-viewer",
+			Plans: []apisv1alpha1.CatalogPlan{{Name: "free", Category: "APPLICATION", Unlimited: true}},
+		},
+		{
+			Name:             "postgresql-db",
+			DisplayName:      "PostgreSQL, Hyperscaler Option",
+			BusinessCategory: "FOUNDATION_CROSS_SERVICES",
+			Plans: []apisv1alpha1.CatalogPlan{
+				{Name: "development", UniqueIdentifier: "postgresql-db-development", Category: "SERVICE", Beta: true, Amount: 5, RemainingAmount: 5, Regions: []string{"eu10", "us10"}},
+				{Name: "storage", UniqueIdentifier: "postgresql-db-storage", Category: "SERVICE", Amount: 100, RemainingAmount: 40},
+			},
+		},
+	}
+	got := catalogServices(globalAccountEntitlements(), nil)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("catalogServices(...): services and plans are sorted by name, regions are deduplicated, -want, +got:\n%s\n", diff)
+	}
+
+	got = catalogServices(globalAccountEntitlements(), []string{"auditlog-viewer"})
+	if diff := cmp.Diff(want[:1], got); diff != "" {
+		t.Errorf("catalogServices(...): only listed services are cataloged, -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestCatalogRegions(t *testing.T) {
+	want := []apisv1alpha1.CatalogRegion{
+		{Name: "cf-eu10", Region: "eu10", Environment: "cloudfoundry", IaasProvider: "AWS"},
+		{Name: "cf-us10", Region: "us10", Environment: "cloudfoundry", IaasProvider: "AWS", SupportsTrial: true},
+	}
+	if diff := cmp.Diff(want, entitlement.Regions(globalAccountDataCenters())); diff != "" {
+		t.Errorf("entitlement.Regions(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -3,10 +3,13 @@ package globalaccount
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -15,14 +18,40 @@ import (
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 )
 
 const (
-	errNotGlobalAccount = "managed resource is not a GlobalAccount custom resource"
+	errNotGlobalAccount    = "managed resource is not a GlobalAccount custom resource"
+	errUpdateGlobalAccount = "Update global account request failed."
+	errGetRegions          = "Get regions of global account request failed."
+
+	// expiryWarningPeriod is how long before its expiry date a global account is reported as expiring
+	expiryWarningPeriod = 30 * 24 * time.Hour
+	msgExpiresSoon      = "global account expires on %s"
+	msgExpired          = "global account expired on %s"
 )
 
+// regionsAPI reads the data centers available to the global account
+type regionsAPI interface {
+	DataCenters(ctx context.Context) (*entclient.DataCenterResponseCollection, error)
+}
+
+type btpRegionsAPI struct {
+	btp btp.Client
+}
+
+func (a btpRegionsAPI) DataCenters(ctx context.Context) (*entclient.DataCenterResponseCollection, error) {
+	response, raw, err := a.btp.RegionsServiceClient.GetAllowedDataCenters(ctx).Execute()
+	return response, apierror.New(err, raw)
+}
+
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
@@ -51,6 +80,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	return &external{
 		Client:  c.kube,
 		btp:     *btpclient,
+		regions: btpRegionsAPI{btp: *btpclient},
 		tracker: c.resourcetracker,
 	}, nil
 }
@@ -62,6 +92,7 @@ type external struct {
 	// would be something like an AWS SDK client.
 	client.Client
 	btp     btp.Client
+	regions regionsAPI
 	tracker tracking.ReferenceResolverTracker
 }
 
@@ -92,17 +123,89 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New("BTP Global Account GUID is empty")
 	}
 
-	cr.Status.AtProvider.Guid = globalAccountGuid
+	dataCenters, err := c.regions.DataCenters(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRegions)
+	}
+
+	cr.Status.AtProvider = generateObservation(response)
+	cr.Status.AtProvider.Regions = entitlement.Regions(dataCenters)
 
-	cr.SetConditions(xpv1.Available())
+	cr.SetConditions(xpv1.Available(), expiryCondition(cr.Status.AtProvider.Contract.ExpiryDate, time.Now()))
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  true,
+		ResourceUpToDate:  isUpToDate(cr.Spec.ForProvider, response),
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
 }
 
+func generateObservation(ga *accountclient.GlobalAccountResponseObject) apisv1alpha1.GlobalAccountObservation {
+	return apisv1alpha1.GlobalAccountObservation{
+		Guid:         ga.Guid,
+		DisplayName:  ga.DisplayName,
+		Description:  ga.Description,
+		Labels:       internal.Val(ga.Labels),
+		Subdomain:    ga.Subdomain,
+		EntityState:  ga.EntityState,
+		StateMessage: ga.StateMessage,
+		Contract: apisv1alpha1.GlobalAccountContract{
+			CommercialModel:               ga.CommercialModel,
+			ConsumptionBased:              ga.ConsumptionBased,
+			LicenseType:                   ga.LicenseType,
+			UseFor:                        ga.UseFor,
+			ContractStatus:                ga.ContractStatus,
+			TerminationNotificationStatus: ga.TerminationNotificationStatus,
+			GeoAccess:                     ga.GeoAccess,
+			BackwardCompliantEU:           ga.BackwardCompliantEU,
+			ExpiryDate:                    toTime(ga.ExpiryDate),
+			RenewalDate:                   toTime(ga.RenewalDate),
+		},
+	}
+}
+
+// toTime converts the unix milliseconds used by the accounts service
+func toTime(millis *int64) *metav1.Time {
+	if millis == nil || *millis == 0 {
+		return nil
+	}
+	t := metav1.NewTime(time.UnixMilli(*millis).UTC())
+	return &t
+}
+
+// isUpToDate compares only the fields set in the spec, unset fields are not managed.
+func isUpToDate(params apisv1alpha1.GlobalAccountParameters, ga *accountclient.GlobalAccountResponseObject) bool {
+	if params.DisplayName != nil && *params.DisplayName != ga.DisplayName {
+		return false
+	}
+	if params.Description != nil && *params.Description != ga.Description {
+		return false
+	}
+	if params.Labels != nil && !labelsEqual(params.Labels, internal.Val(ga.Labels)) {
+		return false
+	}
+	return true
+}
+
+func labelsEqual(desired, observed map[string][]string) bool {
+	if len(desired) == 0 && len(observed) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(desired, observed)
+}
+
+// expiryCondition reports global accounts expiring within the warning period, e.g. trial accounts
+func expiryCondition(expiry *metav1.Time, now time.Time) xpv1.Condition {
+	switch {
+	case expiry == nil || expiry.Sub(now) > expiryWarningPeriod:
+		return apisv1alpha1.NotExpiring()
+	case expiry.Before(&metav1.Time{Time: now}):
+		return apisv1alpha1.Expiring(apisv1alpha1.ExpiredReason, fmt.Sprintf(msgExpired, expiry.Format(time.RFC3339)))
+	default:
+		return apisv1alpha1.Expiring(apisv1alpha1.ExpiresSoonReason, fmt.Sprintf(msgExpiresSoon, expiry.Format(time.RFC3339)))
+	}
+}
+
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*apisv1alpha1.GlobalAccount)
 	if !ok {
@@ -122,7 +225,16 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotGlobalAccount)
 	}
 
-	_ = fmt.Sprintf("Updating: %+v", cr)
+	payload := accountclient.UpdateGlobalAccountRequestPayload{
+		DisplayName: cr.Spec.ForProvider.DisplayName,
+		Description: cr.Spec.ForProvider.Description,
+	}
+	if cr.Spec.ForProvider.Labels != nil {
+		payload.Labels = &cr.Spec.ForProvider.Labels
+	}
+	if _, _, err := c.btp.AccountsServiceClient.GlobalAccountOperationsAPI.UpdateGlobalAccount(ctx).UpdateGlobalAccountRequestPayload(payload).Execute(); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateGlobalAccount)
+	}
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -2,17 +2,90 @@ package globalaccount
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
 )
 
+type mockGlobalAccountClient struct {
+	accountclient.GlobalAccountOperationsAPI
+
+	globalAccount *accountclient.GlobalAccountResponseObject
+	updateErr     error
+	updates       *int
+}
+
+func (m mockGlobalAccountClient) GetGlobalAccount(ctx context.Context) accountclient.ApiGetGlobalAccountRequest {
+	return accountclient.ApiGetGlobalAccountRequest{ApiService: m}
+}
+
+func (m mockGlobalAccountClient) GetGlobalAccountExecute(r accountclient.ApiGetGlobalAccountRequest) (*accountclient.GlobalAccountResponseObject, *http.Response, error) {
+	return m.globalAccount, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func (m mockGlobalAccountClient) UpdateGlobalAccount(ctx context.Context) accountclient.ApiUpdateGlobalAccountRequest {
+	return accountclient.ApiUpdateGlobalAccountRequest{ApiService: m}
+}
+
+func (m mockGlobalAccountClient) UpdateGlobalAccountExecute(r accountclient.ApiUpdateGlobalAccountRequest) (*accountclient.GlobalAccountResponseObject, *http.Response, error) {
+	*m.updates++
+	return m.globalAccount, &http.Response{StatusCode: http.StatusOK}, m.updateErr
+}
+
+type mockRegionsAPI struct {
+	dataCenters *entclient.DataCenterResponseCollection
+	err         error
+}
+
+func (m mockRegionsAPI) DataCenters(ctx context.Context) (*entclient.DataCenterResponseCollection, error) {
+	return m.dataCenters, m.err
+}
+
+func dataCenters() *entclient.DataCenterResponseCollection {
+	return &entclient.DataCenterResponseCollection{Datacenters: []entclient.DataCenterResponseObject{
+		{Name: internal.Ptr("cf-us10"), Region: internal.Ptr("us10"), Environment: internal.Ptr("cloudfoundry"), SupportsTrial: internal.Ptr(true)},
+		{Name: internal.Ptr("cf-eu10"), Region: internal.Ptr("eu10"), Environment: internal.Ptr("cloudfoundry")},
+	}}
+}
+
+func newGlobalAccount(params apisv1alpha1.GlobalAccountParameters) *apisv1alpha1.GlobalAccount {
+	return &apisv1alpha1.GlobalAccount{Spec: apisv1alpha1.GlobalAccountSpec{ForProvider: params}}
+}
+
+func trialAccount() *accountclient.GlobalAccountResponseObject {
+	return &accountclient.GlobalAccountResponseObject{
+		Guid:            "ga-123",
+		DisplayName:     "My Trial",
+		Description:     "Trial account",
+		CommercialModel: "Subscription",
+		LicenseType:     "TRIAL",
+		GeoAccess:       "STANDARD",
+		ContractStatus:  internal.Ptr("ACTIVE"),
+		Labels:          &map[string][]string{"team": {"a"}},
+		ExpiryDate:      internal.Ptr(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()),
+	}
+}
+
+func btpWith(m mockGlobalAccountClient) btp.Client {
+	return btp.Client{AccountsServiceClient: &accountclient.APIClient{GlobalAccountOperationsAPI: m}}
+}
+
 // Unlike many Kubernetes projects Crossplane does not use third party testing
 // libraries, per the common Go test review comments. Crossplane encourages the
 // use of table driven unit tests. The tests of the crossplane-runtime project
@@ -24,6 +97,7 @@ import (
 func TestObserve(t *testing.T) {
 	type fields struct {
 		service btp.Client
+		regions regionsAPI
 	}
 
 	type args struct {
@@ -42,12 +116,67 @@ func TestObserve(t *testing.T) {
 		args   args
 		want   want
 	}{
-		// TODO: Add test cases.
+		"Unset": {
+			reason: "Unset fields are not managed and not initialized from the global account",
+			fields: fields{service: btpWith(mockGlobalAccountClient{globalAccount: trialAccount()}), regions: mockRegionsAPI{dataCenters: dataCenters()}},
+			args:   args{ctx: context.Background(), mg: newGlobalAccount(apisv1alpha1.GlobalAccountParameters{})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"DisplayNameChanged": {
+			reason: "A changed display name needs an update, even if the description is unset",
+			fields: fields{service: btpWith(mockGlobalAccountClient{globalAccount: trialAccount()}), regions: mockRegionsAPI{dataCenters: dataCenters()}},
+			args: args{ctx: context.Background(), mg: newGlobalAccount(apisv1alpha1.GlobalAccountParameters{
+				DisplayName: internal.Ptr("Renamed"),
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"UpToDate": {
+			reason: "Matching attributes need no update",
+			fields: fields{service: btpWith(mockGlobalAccountClient{globalAccount: trialAccount()}), regions: mockRegionsAPI{dataCenters: dataCenters()}},
+			args: args{ctx: context.Background(), mg: newGlobalAccount(apisv1alpha1.GlobalAccountParameters{
+				DisplayName: internal.Ptr("My Trial"),
+				Description: internal.Ptr("Trial account"),
+				Labels:      map[string][]string{"team": {"a"}},
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"NeedsUpdate": {
+			reason: "Changed labels need an update",
+			fields: fields{service: btpWith(mockGlobalAccountClient{globalAccount: trialAccount()}), regions: mockRegionsAPI{dataCenters: dataCenters()}},
+			args: args{ctx: context.Background(), mg: newGlobalAccount(apisv1alpha1.GlobalAccountParameters{
+				DisplayName: internal.Ptr("My Trial"),
+				Description: internal.Ptr("Trial account"),
+				Labels:      map[string][]string{"team": {"b"}},
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"RegionsFailed": {
+			reason: "Errors reading the regions of the global account are returned",
+			fields: fields{service: btpWith(mockGlobalAccountClient{globalAccount: trialAccount()}), regions: mockRegionsAPI{err: errors.New("unauthorized")}},
+			args:   args{ctx: context.Background(), mg: newGlobalAccount(apisv1alpha1.GlobalAccountParameters{})},
+			want:   want{err: errors.Wrap(errors.New("unauthorized"), errGetRegions)},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{btp: tc.fields.service}
+			e := external{btp: tc.fields.service, regions: tc.fields.regions, tracker: trackingtest.NoOpReferenceResolverTracker{}}
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -58,3 +187,77 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestObserveContract(t *testing.T) {
+	e := external{btp: btpWith(mockGlobalAccountClient{globalAccount: trialAccount()}), regions: mockRegionsAPI{dataCenters: dataCenters()}, tracker: trackingtest.NoOpReferenceResolverTracker{}}
+	cr := newGlobalAccount(apisv1alpha1.GlobalAccountParameters{})
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	want := apisv1alpha1.GlobalAccountContract{
+		CommercialModel: "Subscription",
+		LicenseType:     "TRIAL",
+		GeoAccess:       "STANDARD",
+		ContractStatus:  internal.Ptr("ACTIVE"),
+		ExpiryDate:      &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if diff := cmp.Diff(want, cr.Status.AtProvider.Contract); diff != "" {
+		t.Errorf("e.Observe(...): -want contract, +got contract:\n%s\n", diff)
+	}
+	wantRegions := []apisv1alpha1.CatalogRegion{
+		{Name: "cf-eu10", Region: "eu10", Environment: "cloudfoundry"},
+		{Name: "cf-us10", Region: "us10", Environment: "cloudfoundry", SupportsTrial: true},
+	}
+	if diff := cmp.Diff(wantRegions, cr.Status.AtProvider.Regions); diff != "" {
+		t.Errorf("e.Observe(...): -want regions, +got regions:\n%s\n", diff)
+	}
+	if cr.Status.AtProvider.Guid != "ga-123" {
+		t.Errorf("e.Observe(...): want guid ga-123, got %s", cr.Status.AtProvider.Guid)
+	}
+}
+
+func TestExpiryCondition(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		expiry *metav1.Time
+		want   xpv1.ConditionReason
+	}{
+		"NoExpiry":    {want: apisv1alpha1.NoExpiryReason},
+		"Later":       {expiry: &metav1.Time{Time: now.Add(60 * 24 * time.Hour)}, want: apisv1alpha1.NoExpiryReason},
+		"ExpiresSoon": {expiry: &metav1.Time{Time: now.Add(7 * 24 * time.Hour)}, want: apisv1alpha1.ExpiresSoonReason},
+		"Expired":     {expiry: &metav1.Time{Time: now.Add(-time.Hour)}, want: apisv1alpha1.ExpiredReason},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := expiryCondition(tc.expiry, now).Reason; got != tc.want {
+				t.Errorf("expiryCondition(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		updateErr error
+		wantErr   error
+	}{
+		"Success": {},
+		"APIError": {
+			updateErr: errors.New("forbidden"),
+			wantErr:   errors.Wrap(errors.New("forbidden"), errUpdateGlobalAccount),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updates := 0
+			e := external{btp: btpWith(mockGlobalAccountClient{globalAccount: trialAccount(), updateErr: tc.updateErr, updates: &updates})}
+			_, err := e.Update(context.Background(), newGlobalAccount(apisv1alpha1.GlobalAccountParameters{DisplayName: internal.Ptr("Renamed")}))
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if updates != 1 {
+				t.Errorf("e.Update(...): want one update request, got %d", updates)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package subaccount
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
+)
+
+const (
+	subaccountAdminRoleCollection = "Subaccount Administrator"
+
+	errAdminsCredentials = "cannot read subaccountAdminsApiCredentials"
+)
+
+// adminsAccessor connects to the xsuaa api of the subaccount, it returns nil if admins are not reconciled.
+// The credentials are only read once the subaccount exists, since they usually are created for it.
+func (c *external) adminsAccessor(ctx context.Context, cr *apisv1alpha1.Subaccount) (admins.Accessor, error) {
+	creds := cr.Spec.ForProvider.SubaccountAdminsApiCredentials
+	if creds == nil {
+		return nil, nil
+	}
+	if c.admins != nil {
+		return c.admins, nil
+	}
+	binding, err := admins.Binding(ctx, c.Client, cr.GetNamespace(), creds.Source, creds.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errAdminsCredentials)
+	}
+	c.admins = c.newAdminsAccessorFn(binding)
+	return c.admins, nil
+}
+
+// observeAdmins records the admins of the subaccount in the status. Once they match the spec, the listed admins are
+// remembered as managed by crossplane, so that they get revoked if removed from the spec later on.
+// Imported subaccounts get their admins back-filled, in which case true is returned.
+func (c *external) observeAdmins(ctx context.Context, cr *apisv1alpha1.Subaccount) (bool, error) {
+	if internal.Val(cr.Status.AtProvider.Status) != subaccountStateOk {
+		return false, nil
+	}
+	accessor, err := c.adminsAccessor(ctx, cr)
+	if err != nil || accessor == nil {
+		return false, err
+	}
+	users, err := admins.List(ctx, accessor, subaccountAdminRoleCollection)
+	if err != nil {
+		return false, err
+	}
+	cr.Status.AtProvider.SubaccountAdmins = &users
+
+	lateInitialized := isImported(cr) && lateInitializeAdmins(&cr.Spec.ForProvider, users)
+	if toAssign, toRevoke := adminsDiff(&cr.Spec.ForProvider, &cr.Status.AtProvider, admins.TechnicalUsers(c.btp.Credential)); len(toAssign) == 0 && len(toRevoke) == 0 {
+		cr.Status.AtProvider.ManagedSubaccountAdmins = append([]string(nil), cr.Spec.ForProvider.SubaccountAdmins...)
+	}
+	return lateInitialized, nil
+}
+
+func (c *external) updateAdmins(ctx context.Context, cr *apisv1alpha1.Subaccount, toAssign, toRevoke []string) error {
+	accessor, err := c.adminsAccessor(ctx, cr)
+	if err != nil || accessor == nil {
+		return err
+	}
+	return admins.Apply(ctx, accessor, subaccountAdminRoleCollection, toAssign, toRevoke)
+}
+
+// adminsDiff compares the desired admins with the observed ones. Nothing is to be done as long as the admins have not been observed.
+// Admins that have never been listed in the spec are only revoked with the Exclusive policy, the users to keep never are.
+func adminsDiff(params *apisv1alpha1.SubaccountParameters, obs *apisv1alpha1.SubaccountObservation, keep []string) (toAssign []string, toRevoke []string) {
+	if params.SubaccountAdminsApiCredentials == nil || obs.SubaccountAdmins == nil {
+		return nil, nil
+	}
+	revocable := obs.ManagedSubaccountAdmins
+	if params.SubaccountAdminsPolicy == apisv1alpha1.SubaccountAdminsExclusive {
+		revocable = *obs.SubaccountAdmins
+	}
+	return admins.Diff(params.SubaccountAdmins, *obs.SubaccountAdmins, revocable, keep)
+}
@@ -0,0 +1,158 @@
+package subaccount
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+func TestAdminsDiff(t *testing.T) {
+	creds := &v1alpha1.SubaccountAdminsApiCredentials{Source: xpv1.CredentialsSourceSecret}
+	tests := map[string]struct {
+		params     v1alpha1.SubaccountParameters
+		obs        v1alpha1.SubaccountObservation
+		keep       []string
+		wantAssign []string
+		wantRevoke []string
+	}{
+		"NoCredentials": {
+			params: v1alpha1.SubaccountParameters{SubaccountAdmins: []string{"a@example.com"}},
+			obs:    v1alpha1.SubaccountObservation{SubaccountAdmins: &[]string{}},
+		},
+		"NotObserved": {
+			params: v1alpha1.SubaccountParameters{SubaccountAdmins: []string{"a@example.com"}, SubaccountAdminsApiCredentials: creds},
+		},
+		"InSyncIgnoringCase": {
+			params: v1alpha1.SubaccountParameters{SubaccountAdmins: []string{"A@example.com"}, SubaccountAdminsApiCredentials: creds},
+			obs:    v1alpha1.SubaccountObservation{SubaccountAdmins: &[]string{"a@example.com"}},
+		},
+		"Exclusive": {
+			params: v1alpha1.SubaccountParameters{
+				SubaccountAdmins:               []string{"a@example.com", "b@example.com"},
+				SubaccountAdminsPolicy:         v1alpha1.SubaccountAdminsExclusive,
+				SubaccountAdminsApiCredentials: creds,
+			},
+			obs:        v1alpha1.SubaccountObservation{SubaccountAdmins: &[]string{"a@example.com", "manual@example.com"}},
+			wantAssign: []string{"b@example.com"},
+			wantRevoke: []string{"manual@example.com"},
+		},
+		"ExclusiveKeepsTechnicalUser": {
+			params: v1alpha1.SubaccountParameters{
+				SubaccountAdmins:               []string{"a@example.com"},
+				SubaccountAdminsPolicy:         v1alpha1.SubaccountAdminsExclusive,
+				SubaccountAdminsApiCredentials: creds,
+			},
+			obs:  v1alpha1.SubaccountObservation{SubaccountAdmins: &[]string{"a@example.com", "Provider@example.com"}},
+			keep: []string{"provider@example.com"},
+		},
+		"DefaultKeepsUnmanaged": {
+			params: v1alpha1.SubaccountParameters{SubaccountAdmins: []string{"a@example.com"}, SubaccountAdminsApiCredentials: creds},
+			obs: v1alpha1.SubaccountObservation{
+				SubaccountAdmins:        &[]string{"a@example.com", "removed@example.com", "manual@example.com"},
+				ManagedSubaccountAdmins: []string{"a@example.com", "removed@example.com"},
+			},
+			wantRevoke: []string{"removed@example.com"},
+		},
+		"KeepUnmanaged": {
+			params: v1alpha1.SubaccountParameters{
+				SubaccountAdmins:               []string{"a@example.com"},
+				SubaccountAdminsPolicy:         v1alpha1.SubaccountAdminsKeepUnmanaged,
+				SubaccountAdminsApiCredentials: creds,
+			},
+			obs: v1alpha1.SubaccountObservation{
+				SubaccountAdmins:        &[]string{"a@example.com", "removed@example.com", "manual@example.com"},
+				ManagedSubaccountAdmins: []string{"a@example.com", "removed@example.com"},
+			},
+			wantRevoke: []string{"removed@example.com"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			toAssign, toRevoke := adminsDiff(&tc.params, &tc.obs, tc.keep)
+			if diff := cmp.Diff(tc.wantAssign, toAssign); diff != "" {
+				t.Errorf("adminsDiff(...): toAssign -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantRevoke, toRevoke); diff != "" {
+				t.Errorf("adminsDiff(...): toRevoke -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserveAndUpdateAdmins(t *testing.T) {
+	accessor := &MockAdminsAccessor{users: []string{"a@example.com", "manual@example.com"}}
+	ctrl := external{admins: accessor, accountsAccessor: &MockAccountsApiAccessor{}}
+	cr := NewSubaccount("unittest-sa",
+		WithData(v1alpha1.SubaccountParameters{
+			SubaccountAdmins:               []string{"a@example.com", "b@example.com"},
+			SubaccountAdminsPolicy:         v1alpha1.SubaccountAdminsExclusive,
+			SubaccountAdminsApiCredentials: &v1alpha1.SubaccountAdminsApiCredentials{Source: xpv1.CredentialsSourceSecret},
+		}),
+		WithStatus(v1alpha1.SubaccountObservation{
+			Status:            internal.Ptr(subaccountStateOk),
+			Description:       internal.Ptr(""),
+			ParentGuid:        internal.Ptr("ga"),
+			GlobalAccountGUID: internal.Ptr("ga"),
+		}))
+
+	if _, err := ctrl.observeAdmins(context.Background(), cr); err != nil {
+		t.Fatalf("observeAdmins(...): %v", err)
+	}
+	if diff := cmp.Diff(&accessor.users, cr.Status.AtProvider.SubaccountAdmins); diff != "" {
+		t.Errorf("observeAdmins(...): -want, +got:\n%s\n", diff)
+	}
+	if cr.Status.AtProvider.ManagedSubaccountAdmins != nil {
+		t.Errorf("observeAdmins(...): admins out of sync must not be recorded as managed")
+	}
+	if !needsUpdate(cr.Spec, cr.Status, nil) {
+		t.Errorf("needsUpdate(...): changed admins should require Update")
+	}
+
+	if _, err := ctrl.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"b@example.com"}, accessor.assigned); diff != "" {
+		t.Errorf("Update(...): assigned -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"manual@example.com"}, accessor.revoked); diff != "" {
+		t.Errorf("Update(...): revoked -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(cr.Spec.ForProvider.SubaccountAdmins, cr.Status.AtProvider.ManagedSubaccountAdmins); diff != "" {
+		t.Errorf("Update(...): managed admins -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestObserveAdminsImported(t *testing.T) {
+	accessor := &MockAdminsAccessor{users: []string{"manual@example.com"}}
+	ctrl := external{admins: accessor, accountsAccessor: &MockAccountsApiAccessor{}}
+	cr := NewSubaccount("unittest-sa",
+		WithManagementPolicies(xpv1.ManagementActionObserve, xpv1.ManagementActionLateInitialize),
+		WithData(v1alpha1.SubaccountParameters{
+			SubaccountAdminsApiCredentials: &v1alpha1.SubaccountAdminsApiCredentials{Source: xpv1.CredentialsSourceSecret},
+		}),
+		WithStatus(v1alpha1.SubaccountObservation{Status: internal.Ptr(subaccountStateOk)}))
+
+	li, err := ctrl.observeAdmins(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("observeAdmins(...): %v", err)
+	}
+	if !li {
+		t.Errorf("observeAdmins(...): back-filled admins must be reported as late initialized")
+	}
+	if diff := cmp.Diff(accessor.users, cr.Spec.ForProvider.SubaccountAdmins); diff != "" {
+		t.Errorf("observeAdmins(...): admins are back-filled from the role collection, -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(accessor.users, cr.Status.AtProvider.ManagedSubaccountAdmins); diff != "" {
+		t.Errorf("observeAdmins(...): back-filled admins are in sync, -want managed, +got:\n%s\n", diff)
+	}
+
+	li, err = ctrl.observeAdmins(context.Background(), cr)
+	if err != nil || li {
+		t.Errorf("observeAdmins(...): admins are only back-filled once, got %v, %v", li, err)
+	}
+}
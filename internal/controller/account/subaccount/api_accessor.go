@@ -5,13 +5,18 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/jobs"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 )
 
 // AccountsApiAccessor abstraction to handle API operations by coordinating to generated api client
 type AccountsApiAccessor interface {
-	MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) error
+	MoveSubaccounts(ctx context.Context, moves []accountclient.MoveSubaccountsRequestPayload) error
 	UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) error
+	GetSubaccountSettings(ctx context.Context, subaccountGuid string) (*accountclient.DataResponseObject, error)
+	UpdateSubaccountSettings(ctx context.Context, subaccountGuid string, settings []accountclient.UpdateEntitySettingsRequestPayload) error
+	DeleteSubaccountSettings(ctx context.Context, subaccountGuid string, keys []string) error
 }
 
 type AccountsClient struct {
@@ -19,23 +24,54 @@ type AccountsClient struct {
 }
 
 func (a *AccountsClient) UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) error {
-	_, _, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+	_, raw, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
 		UpdateSubaccount(ctx, subaccountGuid).
 		UpdateSubaccountRequestPayload(payload).
 		Execute()
-	return err
+	return apierror.New(err, raw)
 }
 
-func (a *AccountsClient) MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) error {
-	if targetId == "" {
-		return errors.New("targetId must be set for move subaccount api call")
+func (a *AccountsClient) MoveSubaccounts(ctx context.Context, moves []accountclient.MoveSubaccountsRequestPayload) error {
+	for _, m := range moves {
+		if m.TargetGuid == "" {
+			return errors.New("targetGuid must be set for move subaccounts api call")
+		}
 	}
-	_, _, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
-		MoveSubaccount(ctx, subaccountGuid).
-		MoveSubaccountRequestPayload(
-			accountclient.MoveSubaccountRequestPayload{TargetAccountGUID: targetId}).
+	_, raw, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		MoveSubaccounts(ctx).
+		MoveSubaccountsRequestPayloadCollection(
+			accountclient.MoveSubaccountsRequestPayloadCollection{SubaccountsToMoveCollection: moves}).
 		Execute()
-	return err
+	return apierror.New(err, raw)
+}
+
+func (a *AccountsClient) GetSubaccountSettings(ctx context.Context, subaccountGuid string) (*accountclient.DataResponseObject, error) {
+	settings, raw, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		GetSubaccountSettings(ctx, subaccountGuid).
+		Execute()
+	return settings, apierror.New(err, raw)
+}
+
+func (a *AccountsClient) UpdateSubaccountSettings(ctx context.Context, subaccountGuid string, settings []accountclient.UpdateEntitySettingsRequestPayload) error {
+	_, raw, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		CreateOrUpdateSubaccountSettings(ctx, subaccountGuid).
+		EntitySettingsRequestPayload(accountclient.EntitySettingsRequestPayload{EntitySettings: settings}).
+		Execute()
+	return apierror.New(err, raw)
+}
+
+func (a *AccountsClient) DeleteSubaccountSettings(ctx context.Context, subaccountGuid string, keys []string) error {
+	_, raw, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		DeleteSubaccountSettings(ctx, subaccountGuid).
+		Keys(keys).
+		Execute()
+	return apierror.New(err, raw)
+}
+
+// JobStatus returns the status of a job of the accounts service
+func (a *AccountsClient) JobStatus(ctx context.Context, id string) (*jobs.Status, error) {
+	return jobs.NewAccountsSource(a.btp.AccountsServiceClient.JobManagementAPI).JobStatus(ctx, id)
 }
 
 var _ AccountsApiAccessor = &AccountsClient{}
+var _ jobs.StatusSource = &AccountsClient{}
@@ -0,0 +1,48 @@
+package subaccount
+
+import (
+	"context"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/jobs"
+)
+
+const (
+	subaccountStateStarted  = "STARTED"
+	subaccountStateCreating = "CREATING"
+)
+
+// observeJob polls the job of the last asynchronous operation and reports its progress as condition.
+// Jobs unknown to the API are no longer tracked, so that the state of the subaccount is considered again.
+func (c *external) observeJob(ctx context.Context, cr *apisv1alpha1.Subaccount) error {
+	job := cr.Status.AtProvider.Job
+	if job == nil {
+		return nil
+	}
+	known, err := c.jobTracker.Poll(ctx, job)
+	if err != nil {
+		return err
+	}
+	if !known {
+		cr.Status.AtProvider.Job = nil
+		return nil
+	}
+	cr.SetConditions(apisv1alpha1.JobCondition(job))
+	return nil
+}
+
+// jobInProgress returns true as long as any tracked job is still processing.
+func jobInProgress(cr *apisv1alpha1.Subaccount) bool {
+	job := cr.Status.AtProvider.Job
+	return job != nil && jobs.InProgress(job, job.Operation)
+}
+
+// operationInProgress returns true while BTP still processes the operation. A tracked job distinguishes processing
+// from failed operations, the state of the subaccount is only considered for operations started without a job.
+func operationInProgress(cr *apisv1alpha1.Subaccount, operation string, state string) bool {
+	if job := cr.Status.AtProvider.Job; job != nil && job.Operation == operation {
+		return jobs.InProgress(job, operation)
+	}
+	return internal.Val(cr.Status.AtProvider.Status) == state
+}
@@ -0,0 +1,213 @@
+package subaccount
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/jobs"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
+)
+
+func TestObserveJob(t *testing.T) {
+	running := func() *v1alpha1.AsyncJob {
+		return &v1alpha1.AsyncJob{ID: "job-1", Operation: jobs.OperationCreate, Status: v1alpha1.JobStatusInProgress}
+	}
+	cases := map[string]struct {
+		job        *v1alpha1.AsyncJob
+		source     *MockJobSource
+		wantStatus string
+		wantReason xpv1.ConditionReason
+		wantCond   corev1.ConditionStatus
+		wantJob    bool
+	}{
+		"NoJob": {
+			source: &MockJobSource{},
+		},
+		"StillProcessing": {
+			job:        running(),
+			source:     &MockJobSource{status: &jobs.Status{Status: v1alpha1.JobStatusInProgress}},
+			wantStatus: v1alpha1.JobStatusInProgress,
+			wantReason: v1alpha1.JobInProgressReason,
+			wantCond:   corev1.ConditionUnknown,
+			wantJob:    true,
+		},
+		"Failed": {
+			job:        running(),
+			source:     &MockJobSource{status: &jobs.Status{Status: v1alpha1.JobStatusFailed, Description: "subdomain taken"}},
+			wantStatus: v1alpha1.JobStatusFailed,
+			wantReason: v1alpha1.JobFailedReason,
+			wantCond:   corev1.ConditionFalse,
+			wantJob:    true,
+		},
+		"Expired": {
+			job:    running(),
+			source: &MockJobSource{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := external{jobTracker: jobs.NewTracker(tc.source)}
+			cr := NewSubaccount("unittest-sa", WithStatus(v1alpha1.SubaccountObservation{Job: tc.job}))
+			if err := ctrl.observeJob(context.Background(), cr); err != nil {
+				t.Fatalf("observeJob(...): %v", err)
+			}
+			job := cr.Status.AtProvider.Job
+			if (job != nil) != tc.wantJob {
+				t.Fatalf("observeJob(...): want tracked job %t, got %v", tc.wantJob, job)
+			}
+			if job == nil {
+				return
+			}
+			if job.Status != tc.wantStatus {
+				t.Errorf("observeJob(...): want job status %s, got %s", tc.wantStatus, job.Status)
+			}
+			cond := cr.GetCondition(v1alpha1.AsyncJobCondition)
+			if cond.Reason != tc.wantReason || cond.Status != tc.wantCond {
+				t.Errorf("observeJob(...): want condition %s/%s, got %s/%s", tc.wantReason, tc.wantCond, cond.Reason, cond.Status)
+			}
+		})
+	}
+}
+
+func TestOperationInProgress(t *testing.T) {
+	cases := map[string]struct {
+		obs  v1alpha1.SubaccountObservation
+		want bool
+	}{
+		"JobProcessing": {
+			obs:  v1alpha1.SubaccountObservation{Job: &v1alpha1.AsyncJob{Operation: jobs.OperationDelete, Status: v1alpha1.JobStatusInProgress}},
+			want: true,
+		},
+		"JobFailedDespiteState": {
+			obs: v1alpha1.SubaccountObservation{
+				Status: internal.Ptr(subaccountStateDeleting),
+				Job:    &v1alpha1.AsyncJob{Operation: jobs.OperationDelete, Status: v1alpha1.JobStatusFailed},
+			},
+			want: false,
+		},
+		"JobOfOtherOperation": {
+			obs: v1alpha1.SubaccountObservation{
+				Status: internal.Ptr(subaccountStateDeleting),
+				Job:    &v1alpha1.AsyncJob{Operation: jobs.OperationCreate, Status: v1alpha1.JobStatusCompleted},
+			},
+			want: true,
+		},
+		"NoJob": {
+			obs:  v1alpha1.SubaccountObservation{Status: internal.Ptr(subaccountStateOk)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := NewSubaccount("unittest-sa", WithStatus(tc.obs))
+			if got := operationInProgress(cr, jobs.OperationDelete, subaccountStateDeleting); got != tc.want {
+				t.Errorf("operationInProgress(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCreateRecordsJob(t *testing.T) {
+	raw := &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}}
+	raw.Header.Set("Location", "/jobs-management/v1/jobs/job-1/status")
+
+	var saved *v1alpha1.Subaccount
+	ctrl := external{
+		Client: &test.MockClient{
+			MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				saved = obj.(*v1alpha1.Subaccount)
+				return nil
+			},
+		},
+		btp: btp.Client{
+			AccountsServiceClient: &accountclient.APIClient{
+				SubaccountOperationsAPI: &MockSubaccountClient{
+					returnSubaccount: &accountclient.SubaccountResponseObject{Guid: "123"},
+					returnRaw:        raw,
+				},
+			},
+		},
+	}
+	cr := NewSubaccount("unittest-sa")
+	if _, err := ctrl.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	want := &v1alpha1.AsyncJob{ID: "job-1", Operation: jobs.OperationCreate, Status: v1alpha1.JobStatusInProgress}
+	if diff := cmp.Diff(want, cr.Status.AtProvider.Job, cmpopts.IgnoreFields(v1alpha1.AsyncJob{}, "StartTime")); diff != "" {
+		t.Errorf("Create(...): job -want, +got:\n%s\n", diff)
+	}
+	if saved == nil || saved.Status.AtProvider.Job == nil {
+		t.Errorf("Create(...): job has not been saved")
+	}
+
+	saved = nil
+	namespaced := NewSubaccount("unittest-sa")
+	namespaced.SetNamespace("team-a")
+	if _, err := ctrl.Create(context.Background(), namespaced); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	if namespaced.Status.AtProvider.Job == nil || saved != nil {
+		t.Errorf("Create(...): the job of a cluster scoped copy of a namespaced subaccount must be recorded, but not saved")
+	}
+}
+
+func TestDeleteWithJob(t *testing.T) {
+	cases := map[string]struct {
+		job         *v1alpha1.AsyncJob
+		wantDeleted bool
+	}{
+		"Processing": {
+			job: &v1alpha1.AsyncJob{ID: "job-1", Operation: jobs.OperationDelete, Status: v1alpha1.JobStatusInProgress},
+		},
+		"FailedIsRetried": {
+			job:         &v1alpha1.AsyncJob{ID: "job-1", Operation: jobs.OperationDelete, Status: v1alpha1.JobStatusFailed},
+			wantDeleted: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			ctrl := external{
+				btp: btp.Client{
+					AccountsServiceClient: &accountclient.APIClient{
+						SubaccountOperationsAPI: &MockSubaccountClient{
+							mockDeleteSubaccountExecute: func(r accountclient.ApiDeleteSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
+								deleted = true
+								raw := &http.Response{StatusCode: http.StatusAccepted, Header: http.Header{}}
+								raw.Header.Set("Location", "/jobs-management/v1/jobs/job-2/status")
+								return &accountclient.SubaccountResponseObject{Guid: "123"}, raw, nil
+							},
+						},
+					},
+				},
+				tracker: trackingtest.NoOpReferenceResolverTracker{},
+			}
+			cr := NewSubaccount("unittest-sa", WithForceDeletion(), WithStatus(v1alpha1.SubaccountObservation{
+				SubaccountGuid: internal.Ptr("123"),
+				Status:         internal.Ptr(subaccountStateDeleting),
+				Job:            tc.job,
+			}))
+			if err := ctrl.Delete(context.Background(), cr); err != nil {
+				t.Fatalf("Delete(...): %v", err)
+			}
+			if deleted != tc.wantDeleted {
+				t.Errorf("Delete(...): want deletion requested %t, got %t", tc.wantDeleted, deleted)
+			}
+			if tc.wantDeleted && cr.Status.AtProvider.Job.ID != "job-2" {
+				t.Errorf("Delete(...): want new job job-2 to be tracked, got %v", cr.Status.AtProvider.Job)
+			}
+		})
+	}
+}
@@ -2,23 +2,55 @@ package subaccount
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
+	"github.com/sap/crossplane-provider-btp/internal/clients/jobs"
+	"github.com/sap/crossplane-provider-btp/internal/clients/subaccountresources"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
 )
 
 type MockAccountsApiAccessor struct {
-	LastMoveTarget string
-	returnErr      error
+	LastMoveTarget    string
+	LastMoves         []accountclient.MoveSubaccountsRequestPayload
+	LastUpdatePayload *accountclient.UpdateSubaccountRequestPayload
+	returnErr         error
+
+	settings        *accountclient.DataResponseObject
+	updatedSettings []accountclient.UpdateEntitySettingsRequestPayload
+	deletedSettings []string
 }
 
-func (m *MockAccountsApiAccessor) MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) error {
-	m.LastMoveTarget = targetId
+func (m *MockAccountsApiAccessor) MoveSubaccounts(ctx context.Context, moves []accountclient.MoveSubaccountsRequestPayload) error {
+	m.LastMoves = moves
+	if len(moves) > 0 {
+		m.LastMoveTarget = moves[len(moves)-1].TargetGuid
+	}
 	return m.returnErr
 }
 
 func (m *MockAccountsApiAccessor) UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) error {
+	m.LastUpdatePayload = &payload
+	return m.returnErr
+}
+
+func (m *MockAccountsApiAccessor) GetSubaccountSettings(ctx context.Context, subaccountGuid string) (*accountclient.DataResponseObject, error) {
+	return m.settings, m.returnErr
+}
+
+func (m *MockAccountsApiAccessor) UpdateSubaccountSettings(ctx context.Context, subaccountGuid string, settings []accountclient.UpdateEntitySettingsRequestPayload) error {
+	m.updatedSettings = settings
+	return m.returnErr
+}
+
+func (m *MockAccountsApiAccessor) DeleteSubaccountSettings(ctx context.Context, subaccountGuid string, keys []string) error {
+	m.deletedSettings = keys
 	return m.returnErr
 }
 
@@ -29,6 +61,9 @@ type MockSubaccountClient struct {
 	returnSubaccount  *accountclient.SubaccountResponseObject
 	mockDeleteSubaccountExecute  func(r accountclient.ApiDeleteSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error)
 	returnErr         error
+	returnRaw         *http.Response
+
+	requestedGuid string
 }
 
 var _ accountclient.SubaccountOperationsAPI = &MockSubaccountClient{}
@@ -46,7 +81,7 @@ func (m *MockSubaccountClient) CreateSubaccount(ctx context.Context) accountclie
 }
 
 func (m *MockSubaccountClient) CreateSubaccountExecute(r accountclient.ApiCreateSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
-	return m.returnSubaccount, nil, m.returnErr
+	return m.returnSubaccount, m.returnRaw, m.returnErr
 }
 
 func (m *MockSubaccountClient) UpdateSubaccount(ctx context.Context, subaccountGUID string) accountclient.ApiUpdateSubaccountRequest {
@@ -195,13 +230,23 @@ func (m *MockSubaccountClient) GetServiceManagerBindingV2Execute(r accountclient
 }
 
 func (m *MockSubaccountClient) GetSubaccount(ctx context.Context, subaccountGUID string) accountclient.ApiGetSubaccountRequest {
-	//TODO implement me
-	panic("implement me")
+	m.requestedGuid = subaccountGUID
+	return accountclient.ApiGetSubaccountRequest{ApiService: m}
 }
 
+// GetSubaccountExecute serves the subaccount with the requested GUID out of returnSubaccounts, or 404 if there is none
 func (m *MockSubaccountClient) GetSubaccountExecute(r accountclient.ApiGetSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
-	//TODO implement me
-	panic("implement me")
+	if m.returnErr != nil {
+		return nil, nil, m.returnErr
+	}
+	if m.returnSubaccounts != nil {
+		for _, account := range m.returnSubaccounts.Value {
+			if account.Guid == m.requestedGuid {
+				return &account, &http.Response{StatusCode: http.StatusOK}, nil
+			}
+		}
+	}
+	return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("subaccount not found")
 }
 
 func (m *MockSubaccountClient) GetSubaccountCustomProperties(ctx context.Context, subaccountGUID string) accountclient.ApiGetSubaccountCustomPropertiesRequest {
@@ -248,3 +293,59 @@ func (m *MockSubaccountClient) SetTransport(transport runtime.ClientTransport) {
 	//TODO implement me
 	panic("implement me")
 }
+
+type MockAdminsAccessor struct {
+	users    []string
+	assigned []string
+	revoked  []string
+}
+
+func (m *MockAdminsAccessor) ListUsers(ctx context.Context, origin, roleCollection string) ([]string, error) {
+	return m.users, nil
+}
+
+func (m *MockAdminsAccessor) AssignRole(ctx context.Context, origin, username, rolecollection string) error {
+	m.assigned = append(m.assigned, username)
+	return nil
+}
+
+func (m *MockAdminsAccessor) RevokeRole(ctx context.Context, origin, username, rolecollection string) error {
+	m.revoked = append(m.revoked, username)
+	return nil
+}
+
+var _ admins.Accessor = &MockAdminsAccessor{}
+
+type MockResourceLister struct {
+	resources []subaccountresources.Resource
+	calls     int
+}
+
+func (m *MockResourceLister) List(ctx context.Context) ([]subaccountresources.Resource, error) {
+	m.calls++
+	return m.resources, nil
+}
+
+var _ ResourceLister = &MockResourceLister{}
+
+// MockTargetTracker resolves the targets of resource usages by the name of the usage
+type MockTargetTracker struct {
+	trackingtest.NoOpReferenceResolverTracker
+	targets map[string]*metav1.PartialObjectMetadata
+}
+
+func (m MockTargetTracker) ResolveTarget(ctx context.Context, ru providerv1alpha1.ResourceUsage) (*metav1.PartialObjectMetadata, error) {
+	return m.targets[ru.Name], nil
+}
+
+// MockJobSource returns the same status for all jobs
+type MockJobSource struct {
+	status *jobs.Status
+	err    error
+}
+
+func (m *MockJobSource) JobStatus(ctx context.Context, id string) (*jobs.Status, error) {
+	return m.status, m.err
+}
+
+var _ jobs.StatusSource = &MockJobSource{}
@@ -0,0 +1,115 @@
+package subaccount
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/batch"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+const (
+	subaccountStateMoveFailed = "MOVE_FAILED"
+
+	// moveBatchWindow is how long moves of concurrent reconciles are collected before they are sent together
+	moveBatchWindow = 2 * time.Second
+	// moveBatchTimeout limits the bulk request, it is independent of the reconciles waiting for it
+	moveBatchTimeout = time.Minute
+	// moveTimeout is how long a subaccount may stay in phase Moving before the move is considered failed and requested again
+	moveTimeout = 30 * time.Minute
+
+	errMoveTimeout = "subaccount did not arrive at %s within %s"
+)
+
+// subaccountMoves is shared by the controllers of cluster scoped and namespaced subaccounts
+var subaccountMoves = newMoveBatcher(moveBatchWindow)
+
+type subaccountMove struct {
+	guid   string
+	source string
+	target string
+}
+
+// moveBatcher collects the moves of subaccounts reconciled at the same time and sends them as one bulk request.
+// Batches are kept per accounts API client, which is shared by all subaccounts of a ProviderConfig.
+type moveBatcher = batch.Batcher[any, string, subaccountMove]
+
+func newMoveBatcher(window time.Duration) *moveBatcher {
+	return batch.New[any](window, moveBatchTimeout, func(m subaccountMove) string { return m.guid }, func(a, b subaccountMove) int {
+		return strings.Compare(a.guid, b.guid)
+	})
+}
+
+// moveSubaccountsFn sends moves as one bulk request with the accessor
+func moveSubaccountsFn(accessor AccountsApiAccessor) batch.SendFn[subaccountMove] {
+	return func(ctx context.Context, moves []subaccountMove) error {
+		return accessor.MoveSubaccounts(ctx, bulkMovePayload(moves))
+	}
+}
+
+// bulkMovePayload groups the moves by source and target, sorted to get stable requests.
+func bulkMovePayload(moves []subaccountMove) []accountclient.MoveSubaccountsRequestPayload {
+	type route struct{ source, target string }
+	byRoute := map[route][]string{}
+	for _, m := range moves {
+		r := route{m.source, m.target}
+		byRoute[r] = append(byRoute[r], m.guid)
+	}
+	payload := make([]accountclient.MoveSubaccountsRequestPayload, 0, len(byRoute))
+	for r, guids := range byRoute {
+		sort.Strings(guids)
+		payload = append(payload, accountclient.MoveSubaccountsRequestPayload{SourceGuid: r.source, TargetGuid: r.target, SubaccountGuids: guids})
+	}
+	sort.Slice(payload, func(i, j int) bool {
+		if payload[i].SourceGuid != payload[j].SourceGuid {
+			return payload[i].SourceGuid < payload[j].SourceGuid
+		}
+		return payload[i].TargetGuid < payload[j].TargetGuid
+	})
+	return payload
+}
+
+// observeMove follows up on a requested move, it completes once the subaccount arrived at its target.
+// Moves that don't complete within moveTimeout fail, so that they are requested again.
+func observeMove(obs *apisv1alpha1.SubaccountObservation, now time.Time) {
+	move := obs.Move
+	if move == nil || move.Phase != apisv1alpha1.SubaccountMoving {
+		return
+	}
+	switch {
+	case internal.Val(obs.ParentGuid) == move.TargetGuid:
+		move.Phase = apisv1alpha1.SubaccountMoved
+	case internal.Val(obs.Status) == subaccountStateMoveFailed:
+		move.Phase = apisv1alpha1.SubaccountMoveFailed
+		move.Message = internal.Val(obs.StatusMessage)
+	case now.Sub(move.StartTime.Time) > moveTimeout:
+		move.Phase = apisv1alpha1.SubaccountMoveFailed
+		move.Message = fmt.Sprintf(errMoveTimeout, move.TargetGuid, moveTimeout)
+	}
+}
+
+// moveRequested returns true while the subaccount is moving to the target, so that the move is not requested again.
+func moveRequested(obs *apisv1alpha1.SubaccountObservation, target string) bool {
+	return obs.Move != nil && obs.Move.Phase == apisv1alpha1.SubaccountMoving && obs.Move.TargetGuid == target
+}
+
+func startedMove(source, target string, err error) *apisv1alpha1.SubaccountMove {
+	move := &apisv1alpha1.SubaccountMove{
+		Phase:      apisv1alpha1.SubaccountMoving,
+		SourceGuid: source,
+		TargetGuid: target,
+		StartTime:  metav1.Now(),
+	}
+	if err != nil {
+		move.Phase = apisv1alpha1.SubaccountMoveFailed
+		move.Message = err.Error()
+	}
+	return move
+}
@@ -0,0 +1,159 @@
+package subaccount
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+type countingMoveAccessor struct {
+	MockAccountsApiAccessor
+	calls int32
+}
+
+func (m *countingMoveAccessor) MoveSubaccounts(ctx context.Context, moves []accountclient.MoveSubaccountsRequestPayload) error {
+	atomic.AddInt32(&m.calls, 1)
+	return m.MockAccountsApiAccessor.MoveSubaccounts(ctx, moves)
+}
+
+func TestMoveBatcher(t *testing.T) {
+	batcher := newMoveBatcher(50 * time.Millisecond)
+	accessor := &countingMoveAccessor{}
+	key := &accountclient.APIClient{}
+
+	moves := []subaccountMove{
+		{guid: "sa-2", source: "dir-a", target: "dir-b"},
+		{guid: "sa-1", source: "dir-a", target: "dir-b"},
+		{guid: "sa-3", source: "global", target: "dir-a"},
+	}
+	var wg sync.WaitGroup
+	for _, m := range moves {
+		wg.Add(1)
+		go func(m subaccountMove) {
+			defer wg.Done()
+			if err := batcher.Add(context.Background(), key, moveSubaccountsFn(accessor), m); err != nil {
+				t.Errorf("Move(...): %v", err)
+			}
+		}(m)
+	}
+	wg.Wait()
+
+	if accessor.calls != 1 {
+		t.Errorf("Move(...): want a single bulk request, got %d", accessor.calls)
+	}
+	want := []accountclient.MoveSubaccountsRequestPayload{
+		{SourceGuid: "dir-a", TargetGuid: "dir-b", SubaccountGuids: []string{"sa-1", "sa-2"}},
+		{SourceGuid: "global", TargetGuid: "dir-a", SubaccountGuids: []string{"sa-3"}},
+	}
+	if diff := cmp.Diff(want, accessor.LastMoves); diff != "" {
+		t.Errorf("Move(...): -want, +got:\n%s\n", diff)
+	}
+	if batcher.Pending() != 0 {
+		t.Errorf("Move(...): sent batches must be removed")
+	}
+}
+
+// failingMoveAccessor rejects bulk requests containing a move to the unknown target
+type failingMoveAccessor struct {
+	MockAccountsApiAccessor
+	calls int32
+}
+
+func (m *failingMoveAccessor) MoveSubaccounts(ctx context.Context, moves []accountclient.MoveSubaccountsRequestPayload) error {
+	atomic.AddInt32(&m.calls, 1)
+	for _, move := range moves {
+		if move.TargetGuid == "unknown" {
+			return errors.New("target directory not found")
+		}
+	}
+	return nil
+}
+
+func TestMoveBatcherAttributesErrors(t *testing.T) {
+	batcher := newMoveBatcher(50 * time.Millisecond)
+	accessor := &failingMoveAccessor{}
+	key := &accountclient.APIClient{}
+
+	moves := []subaccountMove{
+		{guid: "sa-1", source: "dir-a", target: "dir-b"},
+		{guid: "sa-2", source: "dir-a", target: "unknown"},
+	}
+	errs := make([]error, len(moves))
+	var wg sync.WaitGroup
+	for i, m := range moves {
+		wg.Add(1)
+		go func(i int, m subaccountMove) {
+			defer wg.Done()
+			errs[i] = batcher.Add(context.Background(), key, moveSubaccountsFn(accessor), m)
+		}(i, m)
+	}
+	wg.Wait()
+
+	if errs[0] != nil {
+		t.Errorf("Move(...): a valid move must not fail along with the batch, got %v", errs[0])
+	}
+	if errs[1] == nil {
+		t.Errorf("Move(...): the invalid move must fail")
+	}
+	if accessor.calls != 3 {
+		t.Errorf("Move(...): want the failed bulk request and one request per move, got %d", accessor.calls)
+	}
+}
+
+func TestObserveMove(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	moving := func() *v1alpha1.SubaccountMove {
+		return &v1alpha1.SubaccountMove{Phase: v1alpha1.SubaccountMoving, SourceGuid: "dir-a", TargetGuid: "dir-b", StartTime: metav1.NewTime(start)}
+	}
+	cases := map[string]struct {
+		obs         v1alpha1.SubaccountObservation
+		elapsed     time.Duration
+		wantPhase   v1alpha1.SubaccountMovePhase
+		wantChanged bool
+	}{
+		"StillMoving": {
+			obs:         v1alpha1.SubaccountObservation{ParentGuid: internal.Ptr("dir-a"), Status: internal.Ptr("MOVING"), Move: moving()},
+			elapsed:     time.Minute,
+			wantPhase:   v1alpha1.SubaccountMoving,
+			wantChanged: false,
+		},
+		"TimedOut": {
+			obs:         v1alpha1.SubaccountObservation{ParentGuid: internal.Ptr("dir-a"), Status: internal.Ptr(subaccountStateOk), Move: moving()},
+			elapsed:     moveTimeout + time.Minute,
+			wantPhase:   v1alpha1.SubaccountMoveFailed,
+			wantChanged: true,
+		},
+		"Arrived": {
+			obs:         v1alpha1.SubaccountObservation{ParentGuid: internal.Ptr("dir-b"), Status: internal.Ptr(subaccountStateOk), Move: moving()},
+			wantPhase:   v1alpha1.SubaccountMoved,
+			wantChanged: false,
+		},
+		"Failed": {
+			obs:         v1alpha1.SubaccountObservation{ParentGuid: internal.Ptr("dir-a"), Status: internal.Ptr(subaccountStateMoveFailed), StatusMessage: internal.Ptr("target not found"), Move: moving()},
+			wantPhase:   v1alpha1.SubaccountMoveFailed,
+			wantChanged: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observeMove(&tc.obs, start.Add(tc.elapsed))
+			if tc.obs.Move.Phase != tc.wantPhase {
+				t.Errorf("observeMove(...): want phase %s, got %s", tc.wantPhase, tc.obs.Move.Phase)
+			}
+			spec := &v1alpha1.SubaccountParameters{DirectoryGuid: "dir-b"}
+			if got := directoryParentChanged(spec, &tc.obs); got != tc.wantChanged {
+				t.Errorf("directoryParentChanged(...): want %t, got %t", tc.wantChanged, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package subaccount
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/subaccountresources"
+)
+
+const (
+	errDeletionProtected      = "subaccount has deletionProtection enabled, disable it to delete the subaccount"
+	errPreDeleteCheckMissing  = "subaccount has no preDeleteCheck, configure its cloudManagementSecretRef or set its policy to Force to delete the subaccount"
+	errRemainingResources     = "subaccount still contains resources not managed by crossplane, which would be deleted along with it: %s"
+	errPreDeleteSecretSource  = "secrets of the preDeleteCheck of namespaced subaccounts must be in namespace %s"
+	errPreDeleteSecret        = "cannot get secret of the preDeleteCheck"
+	errPreDeleteBinding       = "secret of the cloudManagementSecretRef contains no binding"
+	errListRemainingResources = "cannot list resources remaining in the subaccount"
+	errListManagedResources   = "cannot list resources referencing the subaccount"
+)
+
+// ResourceLister lists the resources of a subaccount, which are deleted along with it
+type ResourceLister interface {
+	List(ctx context.Context) ([]subaccountresources.Resource, error)
+}
+
+var _ ResourceLister = &subaccountresources.Lister{}
+
+var newResourceListerFn = func(ctx context.Context, user *btp.UserCredential, cisBinding []byte, serviceManagerSecret map[string][]byte) (ResourceLister, error) {
+	return subaccountresources.NewListerFromSecrets(ctx, user, cisBinding, serviceManagerSecret)
+}
+
+// checkDeletion refuses the deletion of protected subaccounts and of subaccounts that still contain resources not managed
+// by crossplane. Unless the preDeleteCheck policy is Force, the deletion is blocked by default and the remaining resources
+// are listed again on every attempt, as resources may have been added since the last one.
+// The secrets usually are connection secrets of a CloudManagement and ServiceManager of the subaccount itself, which are
+// deleted before it. Once the check has passed, missing secrets mean nothing is left to list.
+func (c *external) checkDeletion(ctx context.Context, cr *apisv1alpha1.Subaccount) error {
+	if cr.Spec.ForProvider.DeletionProtection {
+		cr.SetConditions(apisv1alpha1.DeletionBlocked(apisv1alpha1.DeletionProtectedReason, errDeletionProtected))
+		return errors.New(errDeletionProtected)
+	}
+	check := cr.Spec.ForProvider.PreDeleteCheck
+	if check != nil && check.Policy == apisv1alpha1.PreDeleteCheckForce {
+		return nil
+	}
+	if check == nil || check.CloudManagementSecretRef == nil {
+		cr.SetConditions(apisv1alpha1.DeletionBlocked(apisv1alpha1.PreDeleteCheckMissingReason, errPreDeleteCheckMissing))
+		return errors.New(errPreDeleteCheckMissing)
+	}
+
+	lister, err := c.resourceLister(ctx, cr, check)
+	if kerrors.IsNotFound(err) && preDeleteCheckPassed(cr) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	remaining, err := lister.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, errListRemainingResources)
+	}
+	managed, err := c.managedExternalNames(ctx, cr)
+	if err != nil {
+		return errors.Wrap(err, errListManagedResources)
+	}
+
+	if unmanaged := unmanagedResources(remaining, managed); len(unmanaged) > 0 {
+		msg := fmt.Sprintf(errRemainingResources, strings.Join(unmanaged, ", "))
+		cr.SetConditions(apisv1alpha1.DeletionBlocked(apisv1alpha1.RemainingResourcesReason, msg))
+		return errors.New(msg)
+	}
+	cr.SetConditions(apisv1alpha1.DeletionNotBlocked())
+	return nil
+}
+
+// preDeleteCheckPassed returns true if the last preDeleteCheck found no remaining resources
+func preDeleteCheckPassed(cr *apisv1alpha1.Subaccount) bool {
+	return cr.GetCondition(apisv1alpha1.DeletionBlockedCondition).Reason == apisv1alpha1.NoRemainingResourcesReason
+}
+
+func (c *external) resourceLister(ctx context.Context, cr *apisv1alpha1.Subaccount, check *apisv1alpha1.SubaccountPreDeleteCheck) (ResourceLister, error) {
+	cisSecret, err := c.preDeleteSecret(ctx, cr, check.CloudManagementSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	cisBinding := cisSecret[providerv1alpha1.RawBindingKey]
+	if cisBinding == nil {
+		return nil, errors.New(errPreDeleteBinding)
+	}
+	var serviceManagerSecret map[string][]byte
+	if check.ServiceManagerSecretRef != nil {
+		if serviceManagerSecret, err = c.preDeleteSecret(ctx, cr, check.ServiceManagerSecretRef); err != nil {
+			return nil, err
+		}
+	}
+	var user *btp.UserCredential
+	if c.btp.Credential != nil {
+		user = c.btp.Credential.UserCredential
+	}
+	return c.newResourceListerFn(ctx, user, cisBinding, serviceManagerSecret)
+}
+
+func (c *external) preDeleteSecret(ctx context.Context, cr *apisv1alpha1.Subaccount, ref *xpv1.SecretReference) (map[string][]byte, error) {
+	if ns := cr.GetNamespace(); ns != "" && ref.Namespace != ns {
+		return nil, errors.Errorf(errPreDeleteSecretSource, ns)
+	}
+	secret := &corev1.Secret{}
+	if err := c.Client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, errors.Wrap(err, errPreDeleteSecret)
+	}
+	return secret.Data, nil
+}
+
+// managedExternalNames collects the external-names of all resources referencing the subaccount. External-names
+// consisting of several IDs, like the one of a CloudManagement, are split up.
+func (c *external) managedExternalNames(ctx context.Context, cr *apisv1alpha1.Subaccount) (map[string]bool, error) {
+	usages := &providerv1alpha1.ResourceUsageList{}
+	if err := c.Client.List(ctx, usages, client.MatchingLabels{providerv1alpha1.LabelKeySourceUid: string(cr.GetUID())}); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, usage := range usages.Items {
+		target, err := c.tracker.ResolveTarget(ctx, usage)
+		if kerrors.IsNotFound(err) || target == nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range strings.Split(meta.GetExternalName(target), "/") {
+			if part != "" {
+				names[part] = true
+			}
+		}
+	}
+	return names, nil
+}
+
+// unmanagedResources describes all resources, whose ID or name doesn't match an external-name of a managed resource
+func unmanagedResources(resources []subaccountresources.Resource, managed map[string]bool) []string {
+	var unmanaged []string
+	for _, r := range resources {
+		if managed[r.ID] || managed[r.Name] {
+			continue
+		}
+		unmanaged = append(unmanaged, r.String())
+	}
+	return unmanaged
+}
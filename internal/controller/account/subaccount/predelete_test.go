@@ -0,0 +1,133 @@
+package subaccount
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/subaccountresources"
+)
+
+func TestCheckDeletion(t *testing.T) {
+	cloudManagement := &metav1.PartialObjectMetadata{}
+	meta.SetExternalName(cloudManagement, "cis-instance/cis-binding")
+	kyma := &metav1.PartialObjectMetadata{}
+	meta.SetExternalName(kyma, "kyma-env")
+
+	kube := func(secretDeleted bool) *test.MockClient {
+		return &test.MockClient{
+			MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+				if secretDeleted {
+					return kerrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+				}
+				obj.(*corev1.Secret).Data = map[string][]byte{providerv1alpha1.RawBindingKey: []byte("{}")}
+				return nil
+			},
+			MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				list.(*providerv1alpha1.ResourceUsageList).Items = []providerv1alpha1.ResourceUsage{
+					{ObjectMeta: metav1.ObjectMeta{Name: "cloudmanagement"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "kyma"}},
+				}
+				return nil
+			},
+		}
+	}
+	tracker := MockTargetTracker{targets: map[string]*metav1.PartialObjectMetadata{"cloudmanagement": cloudManagement, "kyma": kyma}}
+	managedOnly := []subaccountresources.Resource{
+		{Kind: subaccountresources.KindEnvironment, ID: "kyma-env", Name: "kyma"},
+		{Kind: subaccountresources.KindServiceInstance, ID: "cis-instance", Name: "cis-local"},
+	}
+	withUnmanaged := append(managedOnly, subaccountresources.Resource{Kind: subaccountresources.KindSubscription, ID: "sub", Name: "manual-app"})
+	check := &v1alpha1.SubaccountPreDeleteCheck{CloudManagementSecretRef: &xpv1.SecretReference{Name: "cis", Namespace: "default"}}
+
+	tests := map[string]struct {
+		params        v1alpha1.SubaccountParameters
+		conditions    []xpv1.Condition
+		secretDeleted bool
+		resources     []subaccountresources.Resource
+		wantErr       string
+		wantReason    xpv1.ConditionReason
+		wantCalls     int
+	}{
+		"NoCheck": {
+			wantErr:    errPreDeleteCheckMissing,
+			wantReason: v1alpha1.PreDeleteCheckMissingReason,
+		},
+		"DeletionProtection": {
+			params:     v1alpha1.SubaccountParameters{DeletionProtection: true},
+			wantErr:    errDeletionProtected,
+			wantReason: v1alpha1.DeletionProtectedReason,
+		},
+		"Force": {
+			params: v1alpha1.SubaccountParameters{PreDeleteCheck: &v1alpha1.SubaccountPreDeleteCheck{Policy: v1alpha1.PreDeleteCheckForce}},
+		},
+		"OnlyManagedResources": {
+			params:     v1alpha1.SubaccountParameters{PreDeleteCheck: check},
+			resources:  managedOnly,
+			wantReason: v1alpha1.NoRemainingResourcesReason,
+			wantCalls:  1,
+		},
+		"UnmanagedResources": {
+			params:     v1alpha1.SubaccountParameters{PreDeleteCheck: check},
+			resources:  withUnmanaged,
+			wantErr:    "subscription manual-app",
+			wantReason: v1alpha1.RemainingResourcesReason,
+			wantCalls:  1,
+		},
+		"PassedBefore": {
+			params:     v1alpha1.SubaccountParameters{PreDeleteCheck: check},
+			conditions: []xpv1.Condition{v1alpha1.DeletionNotBlocked()},
+			resources:  withUnmanaged,
+			wantErr:    "subscription manual-app",
+			wantReason: v1alpha1.RemainingResourcesReason,
+			wantCalls:  1,
+		},
+		"SecretDeletedAfterPassing": {
+			params:        v1alpha1.SubaccountParameters{PreDeleteCheck: check},
+			conditions:    []xpv1.Condition{v1alpha1.DeletionNotBlocked()},
+			secretDeleted: true,
+			wantReason:    v1alpha1.NoRemainingResourcesReason,
+		},
+		"SecretDeletedWithoutPassing": {
+			params:        v1alpha1.SubaccountParameters{PreDeleteCheck: check},
+			secretDeleted: true,
+			wantErr:       errPreDeleteSecret,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lister := &MockResourceLister{resources: tc.resources}
+			ctrl := external{
+				Client:  kube(tc.secretDeleted),
+				tracker: tracker,
+				newResourceListerFn: func(ctx context.Context, user *btp.UserCredential, cisBinding []byte, serviceManagerSecret map[string][]byte) (ResourceLister, error) {
+					return lister, nil
+				},
+			}
+			cr := NewSubaccount("unittest-sa", WithData(tc.params), WithConditions(tc.conditions...))
+
+			err := ctrl.checkDeletion(context.Background(), cr)
+			if tc.wantErr == "" && err != nil || tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("checkDeletion(...): want error %q, got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.wantReason, cr.GetCondition(v1alpha1.DeletionBlockedCondition).Reason); diff != "" {
+				t.Errorf("checkDeletion(...): condition reason -want, +got:\n%s\n", diff)
+			}
+			if lister.calls != tc.wantCalls {
+				t.Errorf("checkDeletion(...): want %d list calls, got %d", tc.wantCalls, lister.calls)
+			}
+		})
+	}
+}
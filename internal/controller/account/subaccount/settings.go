@@ -0,0 +1,67 @@
+package subaccount
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/accountmetadata"
+)
+
+const (
+	errGetSettings    = "cannot get entity settings of subaccount"
+	errUpdateSettings = "cannot update entity settings of subaccount"
+	errDeleteSettings = "cannot delete entity settings of subaccount"
+)
+
+// observeSettings records the entity settings of the subaccount in the status, as long as they are managed.
+func (c *external) observeSettings(ctx context.Context, cr *apisv1alpha1.Subaccount) error {
+	if cr.Spec.ForProvider.EntitySettings == nil {
+		cr.Status.AtProvider.EntitySettings = nil
+		return nil
+	}
+	if internal.Val(cr.Status.AtProvider.Status) != subaccountStateOk {
+		return nil
+	}
+	data, err := c.accountsAccessor.GetSubaccountSettings(ctx, internal.Val(cr.Status.AtProvider.SubaccountGuid))
+	if err != nil {
+		return errors.Wrap(err, errGetSettings)
+	}
+	settings, err := accountmetadata.ObservedSettings(data)
+	if err != nil {
+		return errors.Wrap(err, errGetSettings)
+	}
+	cr.Status.AtProvider.EntitySettings = settings
+	return nil
+}
+
+// settingsChanged returns true if managed entity settings differ from the observed ones.
+// Nothing is changed as long as the settings have not been observed.
+func settingsChanged(params *apisv1alpha1.SubaccountParameters, obs *apisv1alpha1.SubaccountObservation) (bool, error) {
+	if obs.EntitySettings == nil {
+		return false, nil
+	}
+	toUpdate, toDelete, err := accountmetadata.SettingsDiff(params.EntitySettings, obs.EntitySettings)
+	return len(toUpdate) > 0 || len(toDelete) > 0, err
+}
+
+func (c *external) updateSettings(ctx context.Context, cr *apisv1alpha1.Subaccount) error {
+	toUpdate, toDelete, err := accountmetadata.SettingsDiff(cr.Spec.ForProvider.EntitySettings, cr.Status.AtProvider.EntitySettings)
+	if err != nil {
+		return err
+	}
+	guid := internal.Val(cr.Status.AtProvider.SubaccountGuid)
+	if len(toUpdate) > 0 {
+		if err := c.accountsAccessor.UpdateSubaccountSettings(ctx, guid, toUpdate); err != nil {
+			return errors.Wrap(err, errUpdateSettings)
+		}
+	}
+	if len(toDelete) > 0 {
+		if err := c.accountsAccessor.DeleteSubaccountSettings(ctx, guid, toDelete); err != nil {
+			return errors.Wrap(err, errDeleteSettings)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package subaccount
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+func TestObserveAndUpdateSettings(t *testing.T) {
+	accessor := &MockAccountsApiAccessor{settings: &accountclient.DataResponseObject{Values: []accountclient.PropertyDataResponseObject{
+		{Key: "owner", Value: map[string]interface{}{"name": "team-a"}},
+		{Key: "manual", Value: map[string]interface{}{"enabled": true}},
+	}}}
+	ctrl := external{accountsAccessor: accessor}
+	cr := NewSubaccount("unittest-sa",
+		WithData(v1alpha1.SubaccountParameters{
+			DisplayName:      "unittest-sa",
+			CustomProperties: map[string]string{"costCenter": "4711"},
+			EntitySettings:   map[string]runtime.RawExtension{"owner": {Raw: []byte(`{"name":"team-b"}`)}},
+		}),
+		WithStatus(v1alpha1.SubaccountObservation{
+			SubaccountGuid:    internal.Ptr("123"),
+			Status:            internal.Ptr(subaccountStateOk),
+			Description:       internal.Ptr(""),
+			DisplayName:       internal.Ptr("unittest-sa"),
+			UsedForProduction: internal.Ptr(""),
+			BetaEnabled:       internal.Ptr(false),
+			Labels:            &map[string][]string{"costCenter": {"4711"}},
+			ParentGuid:        internal.Ptr("ga"),
+			GlobalAccountGUID: internal.Ptr("ga"),
+		}))
+
+	if attributesChanged(cr.Spec, cr.Status) {
+		t.Errorf("attributesChanged(...): custom properties stored as labels should be in sync")
+	}
+	if err := ctrl.observeSettings(context.Background(), cr); err != nil {
+		t.Fatalf("observeSettings(...): %v", err)
+	}
+	if len(cr.Status.AtProvider.EntitySettings) != 2 {
+		t.Errorf("observeSettings(...): expected 2 observed settings, got %v", cr.Status.AtProvider.EntitySettings)
+	}
+	if needsUpdate, err := ctrl.needsUpdate(cr, context.Background()); err != nil || !needsUpdate {
+		t.Errorf("needsUpdate(...): changed settings should require Update, got %v, %v", needsUpdate, err)
+	}
+
+	if _, err := ctrl.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	wantUpdated := []accountclient.UpdateEntitySettingsRequestPayload{{Key: "owner", Value: map[string]interface{}{"name": "team-b"}}}
+	if diff := cmp.Diff(wantUpdated, accessor.updatedSettings); diff != "" {
+		t.Errorf("Update(...): updated settings -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"manual"}, accessor.deletedSettings); diff != "" {
+		t.Errorf("Update(...): deleted settings -want, +got:\n%s\n", diff)
+	}
+	if accessor.LastUpdatePayload != nil {
+		t.Errorf("Update(...): subaccount attributes in sync must not be updated")
+	}
+}
+
+func TestAddOperatorLabelWithCustomProperties(t *testing.T) {
+	cr := NewSubaccount("unittest-sa", WithData(v1alpha1.SubaccountParameters{
+		CustomProperties: map[string]string{"costCenter": "4711"},
+	}))
+	cr.UID = "uid"
+
+	want := map[string][]string{"costCenter": {"4711"}, v1alpha1.SubaccountOperatorLabel: {"uid"}}
+	if diff := cmp.Diff(want, addOperatorLabel(cr)); diff != "" {
+		t.Errorf("addOperatorLabel(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -5,19 +5,27 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
 	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/accountmetadata"
+	"github.com/sap/crossplane-provider-btp/internal/clients/admins"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+	"github.com/sap/crossplane-provider-btp/internal/clients/jobs"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 )
@@ -25,8 +33,10 @@ import (
 const (
 	errNotSubaccount        = "managed resource is not a Subaccount custom resource"
 	errSubaccountNotFound   = "subaccount not found"
+	errSaveJob              = "cannot save job of subaccount creation"
 	subaccountStateDeleting = "DELETING"
 	subaccountStateOk       = "OK"
+	usedForProductionUnset  = "UNSET"
 )
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -56,10 +66,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	return &external{
-		Client:           c.kube,
-		btp:              *btpclient,
-		tracker:          c.resourcetracker,
-		accountsAccessor: &AccountsClient{btp: *btpclient},
+		Client:              c.kube,
+		btp:                 *btpclient,
+		tracker:             c.resourcetracker,
+		accountsAccessor:    &AccountsClient{btp: *btpclient},
+		jobTracker:          jobs.NewTracker(&AccountsClient{btp: *btpclient}),
+		moves:               subaccountMoves,
+		newAdminsAccessorFn: admins.NewXsuaaAccessor,
+		newResourceListerFn: newResourceListerFn,
 	}, nil
 }
 
@@ -73,6 +87,13 @@ type external struct {
 	tracker tracking.ReferenceResolverTracker
 
 	accountsAccessor AccountsApiAccessor
+	jobTracker       *jobs.Tracker
+	moves            *moveBatcher
+
+	newAdminsAccessorFn func(binding *securityv1alpha1.XsuaaBinding) admins.Accessor
+	admins              admins.Accessor
+
+	newResourceListerFn func(ctx context.Context, user *btp.UserCredential, cisBinding []byte, serviceManagerSecret map[string][]byte) (ResourceLister, error)
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -81,7 +102,8 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotSubaccount)
 	}
 
-	if err := c.generateObservation(ctx, desiredCR); err != nil {
+	lateInitialized, err := c.generateObservation(ctx, desiredCR)
+	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
@@ -93,12 +115,36 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
+	if err := c.observeJob(ctx, desiredCR); err != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceLateInitialized: lateInitialized}, err
+	}
+	// nothing to change while BTP still processes an operation
+	if jobInProgress(desiredCR) {
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: lateInitialized,
+			ConnectionDetails:       managed.ConnectionDetails{},
+		}, nil
+	}
+
+	adminsLateInitialized, err := c.observeAdmins(ctx, desiredCR)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceLateInitialized: lateInitialized}, err
+	}
+	lateInitialized = lateInitialized || adminsLateInitialized
+
+	if err := c.observeSettings(ctx, desiredCR); err != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceLateInitialized: lateInitialized}, err
+	}
+
 	// Needs Update?
 	if needsUpdate, err := c.needsUpdate(desiredCR, ctx); needsUpdate || err != nil {
 		return managed.ExternalObservation{
-			ResourceExists:    true,
-			ResourceUpToDate:  !needsUpdate,
-			ConnectionDetails: managed.ConnectionDetails{},
+			ResourceExists:          true,
+			ResourceUpToDate:        !needsUpdate,
+			ResourceLateInitialized: lateInitialized,
+			ConnectionDetails:       managed.ConnectionDetails{},
 		}, err
 	}
 
@@ -107,24 +153,27 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		desiredCR.SetConditions(xpv1.Available())
 	}
 	return managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  true,
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       managed.ConnectionDetails{},
 	}, nil
 }
 
+// generateObservation fills the status from the BTP subaccount, it returns true if a pre-existing subaccount
+// has been adopted or the spec of an imported subaccount has been back-filled, so that these changes get persisted.
 func (c *external) generateObservation(
 	ctx context.Context,
 	desiredState *apisv1alpha1.Subaccount,
-) error {
-	subaccount, err := c.findBTPSubaccount(ctx, desiredState)
+) (bool, error) {
+	subaccount, adopted, err := c.findBTPSubaccount(ctx, desiredState)
 	if err != nil {
 		resetRemoteState(desiredState)
-		return err
+		return false, err
 	}
 	if subaccount == nil {
 		resetRemoteState(desiredState)
-		return nil
+		return false, nil
 	}
 
 	desiredState.Status.AtProvider.SubaccountGuid = &subaccount.Guid
@@ -132,6 +181,7 @@ func (c *external) generateObservation(
 	desiredState.Status.AtProvider.StatusMessage = subaccount.StateMessage
 	desiredState.Status.AtProvider.BetaEnabled = &subaccount.BetaEnabled
 	desiredState.Status.AtProvider.Labels = subaccount.Labels
+	desiredState.Status.AtProvider.CustomProperties = accountmetadata.CustomProperties(subaccount.CustomProperties)
 	desiredState.Status.AtProvider.Description = &subaccount.Description
 	desiredState.Status.AtProvider.Subdomain = &subaccount.Subdomain
 	desiredState.Status.AtProvider.DisplayName = &subaccount.DisplayName
@@ -139,8 +189,75 @@ func (c *external) generateObservation(
 	desiredState.Status.AtProvider.UsedForProduction = &subaccount.UsedForProduction
 	desiredState.Status.AtProvider.ParentGuid = &subaccount.ParentGUID
 	desiredState.Status.AtProvider.GlobalAccountGUID = &subaccount.GlobalAccountGUID
+	observeMove(&desiredState.Status.AtProvider, time.Now())
 
-	return nil
+	if isImported(desiredState) {
+		return lateInitialize(&desiredState.Spec.ForProvider, subaccount) || adopted, nil
+	}
+	return adopted, nil
+}
+
+// isImported returns true if the subaccount is not created by crossplane, since its management policies don't allow it.
+func isImported(cr *apisv1alpha1.Subaccount) bool {
+	policies := cr.GetManagementPolicies()
+	for _, p := range policies {
+		if p == xpv1.ManagementActionAll || p == xpv1.ManagementActionCreate {
+			return false
+		}
+	}
+	return len(policies) > 0
+}
+
+// lateInitialize back-fills unset parameters from the observed subaccount, so that switching an imported subaccount
+// to full management doesn't cause unintended updates. Admins are not part of the subaccount API, they are back-filled
+// by lateInitializeAdmins from the role collection.
+func lateInitialize(params *apisv1alpha1.SubaccountParameters, subaccount *accountclient.SubaccountResponseObject) bool {
+	li := false
+	setString := func(field *string, observed string) {
+		if *field == "" && observed != "" {
+			*field = observed
+			li = true
+		}
+	}
+	setString(&params.DisplayName, subaccount.DisplayName)
+	setString(&params.Description, subaccount.Description)
+	setString(&params.Subdomain, subaccount.Subdomain)
+	setString(&params.Region, subaccount.Region)
+
+	if (params.UsedForProduction == "" || params.UsedForProduction == usedForProductionUnset) && subaccount.UsedForProduction != "" &&
+		params.UsedForProduction != subaccount.UsedForProduction {
+		params.UsedForProduction = subaccount.UsedForProduction
+		li = true
+	}
+	if !params.BetaEnabled && subaccount.BetaEnabled {
+		params.BetaEnabled = true
+		li = true
+	}
+	if params.Labels == nil && subaccount.Labels != nil {
+		labels := map[string][]string{}
+		internal.CopyMaps(labels, *subaccount.Labels)
+		delete(labels, apisv1alpha1.SubaccountOperatorLabel)
+		if len(labels) > 0 {
+			params.Labels = labels
+			li = true
+		}
+	}
+	if emptyDirectoryRef(params) && subaccount.ParentGUID != subaccount.GlobalAccountGUID {
+		params.DirectoryGuid = subaccount.ParentGUID
+		li = true
+	}
+	return li
+}
+
+// lateInitializeAdmins back-fills unset admins from the users of the "Subaccount Administrator" role collection.
+// Since the accounts API doesn't return the admins, this requires subaccountAdminsApiCredentials.
+// Without them the admins of an imported subaccount stay unset.
+func lateInitializeAdmins(params *apisv1alpha1.SubaccountParameters, admins []string) bool {
+	if len(params.SubaccountAdmins) > 0 || len(admins) == 0 {
+		return false
+	}
+	params.SubaccountAdmins = append([]string(nil), admins...)
+	return true
 }
 
 func resetRemoteState(state *apisv1alpha1.Subaccount) {
@@ -165,7 +282,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotSubaccount)
 	}
 
-	if cr.Status.AtProvider.Status != nil && *cr.Status.AtProvider.Status == "STARTED" {
+	if operationInProgress(cr, jobs.OperationCreate, subaccountStateStarted) {
 		return managed.ExternalCreation{}, nil
 	}
 
@@ -175,25 +292,37 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	cr.SetConditions(xpv1.Creating())
 
+	if cr.Status.AtProvider.Job != nil && cr.GetNamespace() == "" {
+		if err := providerconfig.SaveCreationStatus(ctx, c.Client, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errSaveJob)
+		}
+	}
+
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
 }
 
 func (c *external) needsUpdate(cr *apisv1alpha1.Subaccount, ctx context.Context) (bool, error) {
-	if needsUpdate(cr.Spec, cr.Status) {
+	if needsUpdate(cr.Spec, cr.Status, admins.TechnicalUsers(c.btp.Credential)) {
 		return true, nil
 	}
-	return false, nil
+	return settingsChanged(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+}
+
+func needsUpdate(desired apisv1alpha1.SubaccountSpec, actual apisv1alpha1.SubaccountStatus, technicalUsers []string) bool {
+	if toAssign, toRevoke := adminsDiff(&desired.ForProvider, &actual.AtProvider, technicalUsers); len(toAssign) > 0 || len(toRevoke) > 0 {
+		return true
+	}
+	return attributesChanged(desired, actual)
 }
 
-func needsUpdate(desired apisv1alpha1.SubaccountSpec, actual apisv1alpha1.SubaccountStatus) bool {
+func attributesChanged(desired apisv1alpha1.SubaccountSpec, actual apisv1alpha1.SubaccountStatus) bool {
 	cleanedDesired := desired.ForProvider.DeepCopy()
 	cleanedActual := actual.AtProvider.DeepCopy()
 	// Remove non-diff relevant information
 
 	filter(cleanedActual.Labels, apisv1alpha1.SubaccountOperatorLabel)
-	cleanedDesired.SubaccountAdmins = nil
 
 	if cleanedDesired.Description == "" && cleanedActual.Description == nil {
 		cleanedActual.Description = internal.Ptr("")
@@ -208,7 +337,7 @@ func needsUpdate(desired apisv1alpha1.SubaccountSpec, actual apisv1alpha1.Subacc
 	if !reflect.DeepEqual(&cleanedDesired.UsedForProduction, cleanedActual.UsedForProduction) {
 		return true
 	}
-	if changedLabels(cleanedDesired.Labels, cleanedActual.Labels) {
+	if changedLabels(accountmetadata.LabelsWithCustomProperties(cleanedDesired.Labels, cleanedDesired.CustomProperties), cleanedActual.Labels) {
 		return true
 	}
 	if !reflect.DeepEqual(&cleanedDesired.BetaEnabled, cleanedActual.BetaEnabled) {
@@ -235,12 +364,36 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotSubaccount)
 	}
 
-	if cr.Status.AtProvider.Status != nil && *cr.Status.AtProvider.Status == "CREATING" {
+	if operationInProgress(cr, jobs.OperationCreate, subaccountStateCreating) {
 		return managed.ExternalUpdate{}, nil
 	}
 
 	subaccount := cr
 	connectionDetails := managed.ConnectionDetails{}
+	partialUpdate := false
+
+	if toAssign, toRevoke := adminsDiff(&cr.Spec.ForProvider, &cr.Status.AtProvider, admins.TechnicalUsers(c.btp.Credential)); len(toAssign) > 0 || len(toRevoke) > 0 {
+		if err := c.updateAdmins(ctx, cr, toAssign, toRevoke); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.ManagedSubaccountAdmins = append([]string(nil), cr.Spec.ForProvider.SubaccountAdmins...)
+		partialUpdate = true
+	}
+
+	changed, err := settingsChanged(&cr.Spec.ForProvider, &cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if changed {
+		if err := c.updateSettings(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		partialUpdate = true
+	}
+
+	if partialUpdate && !attributesChanged(cr.Spec, cr.Status) {
+		return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
+	}
 
 	if err := c.updateBTPSubaccount(ctx, subaccount); err != nil {
 		return managed.ExternalUpdate{}, err
@@ -258,11 +411,19 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	c.tracker.SetConditions(ctx, cr)
-	if blocked := c.tracker.DeleteShouldBeBlocked(mg); blocked {
+	inUse := c.tracker.DeleteShouldBeBlocked(mg)
+	// The preDeleteCheck runs while the resources using the subaccount, which usually provide its credentials, still exist.
+	// Once it has passed, it is only repeated after they are gone.
+	if !inUse || !preDeleteCheckPassed(cr) {
+		if err := c.checkDeletion(ctx, cr); err != nil {
+			return err
+		}
+	}
+	if inUse {
 		return errors.New(providerv1alpha1.ErrResourceInUse)
 	}
 
-	if cr.Status.AtProvider.Status != nil && *cr.Status.AtProvider.Status == subaccountStateDeleting {
+	if operationInProgress(cr, jobs.OperationDelete, subaccountStateDeleting) {
 		return nil
 	}
 
@@ -283,14 +444,15 @@ func deleteBTPSubaccount(
 	subaccountId := *subaccount.Status.AtProvider.SubaccountGuid
 
 	_, raw, err := accountsServiceClient.AccountsServiceClient.SubaccountOperationsAPI.DeleteSubaccount(ctx, subaccountId).Execute()
-	if raw.StatusCode == 404 {
+	if raw != nil && raw.StatusCode == 404 {
 		ctrl.Log.Info("associated BTP subaccount not found, continue deletion")
 		return nil
 	}
 
 	if err != nil {
-		return errors.Wrap(err, "deletion of subaccount failed")
+		return errors.Wrap(apierror.New(err, raw), "deletion of subaccount failed")
 	}
+	subaccount.Status.AtProvider.Job = jobs.Start(jobs.OperationDelete, raw)
 
 	return nil
 }
@@ -305,15 +467,17 @@ func (c *external) updateBTPSubaccount(
 	}
 }
 
+// moveSubaccountAPI moves the subaccount together with the moves of other subaccounts of the same ProviderConfig,
+// the move is tracked in the status until the subaccount arrived at its target.
 func (c *external) moveSubaccountAPI(ctx context.Context, subaccount *apisv1alpha1.Subaccount) error {
-	guid := subaccount.Status.AtProvider.SubaccountGuid
-	targetID := subaccount.Spec.ForProvider.DirectoryGuid
-	// if not specified we need to set the global account as parent
-	if emptyDirectoryRef(&subaccount.Spec.ForProvider) {
-		targetID = internal.Val(subaccount.Status.AtProvider.GlobalAccountGUID)
+	move := subaccountMove{
+		guid:   internal.Val(subaccount.Status.AtProvider.SubaccountGuid),
+		source: internal.Val(subaccount.Status.AtProvider.ParentGuid),
+		target: moveTarget(&subaccount.Spec.ForProvider, &subaccount.Status.AtProvider),
 	}
 
-	err := c.accountsAccessor.MoveSubaccount(ctx, internal.Val(guid), targetID)
+	err := c.moves.Add(ctx, c.btp.AccountsServiceClient, moveSubaccountsFn(c.accountsAccessor), move)
+	subaccount.Status.AtProvider.Move = startedMove(move.source, move.target, err)
 	if err != nil {
 		return errors.Wrap(err, "moving subaccount failed")
 	}
@@ -344,12 +508,12 @@ func (c *external) createBTPSubaccount(
 	ctx context.Context, subaccount *apisv1alpha1.Subaccount,
 ) error {
 	ctrl.Log.Info(fmt.Sprintf("Creating subaccount: %s", subaccount.Name))
-	createdSubaccount, _, err := c.btp.AccountsServiceClient.SubaccountOperationsAPI.
+	createdSubaccount, raw, err := c.btp.AccountsServiceClient.SubaccountOperationsAPI.
 		CreateSubaccount(ctx).
 		CreateSubaccountRequestPayload(toCreateApiPayload(subaccount)).
 		Execute()
 	if err != nil {
-		return specifyAPIError(err)
+		return apierror.New(err, raw)
 	}
 
 	guid := createdSubaccount.Guid
@@ -357,12 +521,45 @@ func (c *external) createBTPSubaccount(
 	subaccount.Status.AtProvider.SubaccountGuid = &guid
 	subaccount.Status.AtProvider.Status = createdSubaccount.StateMessage
 	subaccount.Status.AtProvider.ParentGuid = &createdSubaccount.ParentGUID
+	subaccount.Status.AtProvider.ManagedSubaccountAdmins = append([]string(nil), subaccount.Spec.ForProvider.SubaccountAdmins...)
+	subaccount.Status.AtProvider.Job = jobs.Start(jobs.OperationCreate, raw)
+	meta.SetExternalName(subaccount, guid)
 
 	return nil
 }
 
+// findBTPSubaccount looks up the subaccount by the GUID stored as external-name. Only if no GUID is known yet,
+// a pre-existing subaccount is adopted by matching subdomain and region, its GUID is set as external-name then.
 func (c *external) findBTPSubaccount(
 	ctx context.Context, subaccount *apisv1alpha1.Subaccount,
+) (*accountclient.SubaccountResponseObject, bool, error) {
+	if guid := externalID(subaccount); guid != "" {
+		found, err := c.getBTPSubaccount(ctx, guid)
+		return found, false, err
+	}
+
+	found, err := c.adoptBTPSubaccount(ctx, subaccount)
+	if err != nil || found == nil {
+		return nil, false, err
+	}
+	meta.SetExternalName(subaccount, found.Guid)
+	return found, true, nil
+}
+
+func (c *external) getBTPSubaccount(ctx context.Context, guid string) (*accountclient.SubaccountResponseObject, error) {
+	account, raw, err := c.btp.AccountsServiceClient.SubaccountOperationsAPI.GetSubaccount(ctx, guid).Execute()
+	if raw != nil && raw.StatusCode == 404 {
+		return nil, nil
+	}
+	if err != nil {
+		ctrl.Log.Error(err, "could not get BTP subaccount")
+		return nil, apierror.New(err, raw)
+	}
+	return account, nil
+}
+
+func (c *external) adoptBTPSubaccount(
+	ctx context.Context, subaccount *apisv1alpha1.Subaccount,
 ) (*accountclient.SubaccountResponseObject, error) {
 	response, _, err := c.btp.AccountsServiceClient.SubaccountOperationsAPI.GetSubaccounts(ctx).Execute()
 	if err != nil {
@@ -382,6 +579,16 @@ func (c *external) findBTPSubaccount(
 	return foundAccount, nil
 }
 
+// externalID returns the GUID stored as external-name, or an empty string as long as crossplane's default
+// (the resource name) is set.
+func externalID(subaccount *apisv1alpha1.Subaccount) string {
+	extName := meta.GetExternalName(subaccount)
+	if _, err := uuid.Parse(extName); err != nil {
+		return ""
+	}
+	return extName
+}
+
 func isRelatedAccount(subaccount *apisv1alpha1.Subaccount, account *accountclient.SubaccountResponseObject) bool {
 	return strings.Compare(
 		subaccount.Spec.ForProvider.Subdomain, account.Subdomain,
@@ -406,17 +613,22 @@ func toCreateApiPayload(subaccount *apisv1alpha1.Subaccount) accountclient.Creat
 	}
 }
 
+// addOperatorLabel returns the labels including custom properties to send, marked with the operator label
 func addOperatorLabel(subaccount *apisv1alpha1.Subaccount) map[string][]string {
-	if subaccount.Spec.ForProvider.Labels == nil {
+	desired := accountmetadata.LabelsWithCustomProperties(subaccount.Spec.ForProvider.Labels, subaccount.Spec.ForProvider.CustomProperties)
+	if desired == nil {
 		return map[string][]string{}
 	}
 	labels := map[string][]string{}
-	internal.CopyMaps(labels, subaccount.Spec.ForProvider.Labels)
+	internal.CopyMaps(labels, desired)
 	labels[apisv1alpha1.SubaccountOperatorLabel] = []string{string(subaccount.UID)}
 	return labels
 }
 
 func directoryParentChanged(spec *apisv1alpha1.SubaccountParameters, status *apisv1alpha1.SubaccountObservation) bool {
+	if moveRequested(status, moveTarget(spec, status)) {
+		return false
+	}
 	supposeGlobal := emptyDirectoryRef(spec)
 	// With no directory specified we expect it to be in global account
 	if supposeGlobal {
@@ -425,20 +637,16 @@ func directoryParentChanged(spec *apisv1alpha1.SubaccountParameters, status *api
 	return !reflect.DeepEqual(status.ParentGuid, &spec.DirectoryGuid)
 }
 
-func emptyDirectoryRef(spec *apisv1alpha1.SubaccountParameters) bool {
-	return spec.DirectoryRef == nil && spec.DirectorySelector == nil && spec.DirectoryGuid == ""
+// moveTarget returns the directory the subaccount is supposed to be in, or otherwise the global account
+func moveTarget(spec *apisv1alpha1.SubaccountParameters, status *apisv1alpha1.SubaccountObservation) string {
+	if emptyDirectoryRef(spec) {
+		return internal.Val(status.GlobalAccountGUID)
+	}
+	return spec.DirectoryGuid
 }
 
-func specifyAPIError(err error) error {
-	if genericErr, ok := err.(*accountclient.GenericOpenAPIError); ok {
-		if accountError, ok := genericErr.Model().(accountclient.ApiExceptionResponseObject); ok {
-			return errors.New(fmt.Sprintf("API Error: %v, Code %v", internal.Val(accountError.Error.Message), internal.Val(accountError.Error.Code)))
-		}
-		if genericErr.Body() != nil {
-			return fmt.Errorf("API Error: %s", string(genericErr.Body()))
-		}
-	}
-	return err
+func emptyDirectoryRef(spec *apisv1alpha1.SubaccountParameters) bool {
+	return spec.DirectoryRef == nil && spec.DirectorySelector == nil && spec.DirectoryGuid == ""
 }
 
 func changedLabels(specLabels map[string][]string, statusLabels *map[string][]string) bool {
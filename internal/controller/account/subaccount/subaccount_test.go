@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
@@ -23,6 +25,11 @@ import (
 	"github.com/sap/crossplane-provider-btp/btp"
 )
 
+const (
+	guidMoved = "1f4a9d4e-5a41-4a8e-9f1f-2c3b1d0e7a11"
+	guidOther = "7c0e2b6d-3e2f-4d1b-8a9c-6b5d4f3e2a10"
+)
+
 func TestObserve(t *testing.T) {
 	type args struct {
 		cr            resource.Managed
@@ -74,6 +81,118 @@ func TestObserve(t *testing.T) {
 				err: errors.New("Error getting subaccount"),
 			},
 		},
+		"ObserveByExternalName": {
+			reason: "A GUID as external-name should be observed directly, regardless of subdomain and region",
+			args: args{
+				cr: NewSubaccount("unittest-sa", WithExternalName(guidMoved), WithData(v1alpha1.SubaccountParameters{
+					Subdomain:   "sub1",
+					Region:      "eu12",
+					DisplayName: "unittest-sa",
+				})),
+				mockAPIClient: &MockSubaccountClient{
+					returnSubaccounts: &accountclient.ResponseCollection{
+						Value: []accountclient.SubaccountResponseObject{
+							{Guid: guidOther, Subdomain: "sub1", Region: "eu12", State: "OK", DisplayName: "other"},
+							{Guid: guidMoved, Subdomain: "sub1", Region: "us10", State: "OK", DisplayName: "unittest-sa"},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				crChanges: func(cr *v1alpha1.Subaccount) {
+					cr.Status.AtProvider.SubaccountGuid = internal.Ptr(guidMoved)
+					cr.Status.AtProvider.Status = internal.Ptr("OK")
+					cr.Status.AtProvider.Region = internal.Ptr("us10")
+					cr.Status.AtProvider.Subdomain = internal.Ptr("sub1")
+					cr.Status.AtProvider.Description = internal.Ptr("")
+					cr.Status.AtProvider.DisplayName = internal.Ptr("unittest-sa")
+					cr.Status.AtProvider.UsedForProduction = internal.Ptr("")
+					cr.Status.AtProvider.BetaEnabled = internal.Ptr(false)
+					cr.Status.AtProvider.ParentGuid = internal.Ptr("")
+					cr.Status.AtProvider.GlobalAccountGUID = internal.Ptr("")
+					cr.SetConditions(xpv1.Available())
+				},
+			},
+		},
+		"ImportObserveOnly": {
+			reason: "An imported subaccount should get its forProvider back-filled from the observed subaccount",
+			args: args{
+				cr: NewSubaccount("unittest-sa", WithExternalName(guidMoved), WithManagementPolicies(xpv1.ManagementActionObserve, xpv1.ManagementActionLateInitialize)),
+				mockAPIClient: &MockSubaccountClient{
+					returnSubaccounts: &accountclient.ResponseCollection{
+						Value: []accountclient.SubaccountResponseObject{
+							{
+								Guid:              guidMoved,
+								Subdomain:         "sub1",
+								Region:            "eu12",
+								State:             "OK",
+								DisplayName:       "unittest-sa",
+								Labels:            &map[string][]string{"team": {"a"}},
+								UsedForProduction: "USED_FOR_PRODUCTION",
+								BetaEnabled:       true,
+								ParentGUID:        "234",
+								GlobalAccountGUID: "ga",
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				crChanges: func(cr *v1alpha1.Subaccount) {
+					cr.Spec.ForProvider = v1alpha1.SubaccountParameters{
+						DisplayName:       "unittest-sa",
+						Subdomain:         "sub1",
+						Region:            "eu12",
+						Labels:            map[string][]string{"team": {"a"}},
+						UsedForProduction: "USED_FOR_PRODUCTION",
+						BetaEnabled:       true,
+						DirectoryGuid:     "234",
+					}
+					cr.Status.AtProvider.SubaccountGuid = internal.Ptr(guidMoved)
+					cr.Status.AtProvider.Status = internal.Ptr("OK")
+					cr.Status.AtProvider.Region = internal.Ptr("eu12")
+					cr.Status.AtProvider.Subdomain = internal.Ptr("sub1")
+					cr.Status.AtProvider.Labels = &map[string][]string{"team": {"a"}}
+					cr.Status.AtProvider.Description = internal.Ptr("")
+					cr.Status.AtProvider.DisplayName = internal.Ptr("unittest-sa")
+					cr.Status.AtProvider.UsedForProduction = internal.Ptr("USED_FOR_PRODUCTION")
+					cr.Status.AtProvider.BetaEnabled = internal.Ptr(true)
+					cr.Status.AtProvider.ParentGuid = internal.Ptr("234")
+					cr.Status.AtProvider.GlobalAccountGUID = internal.Ptr("ga")
+					cr.SetConditions(xpv1.Available())
+				},
+			},
+		},
+		"ExternalNameNotFound": {
+			reason: "A subaccount deleted outside of crossplane needs creation, even if another one matches subdomain and region",
+			args: args{
+				cr: NewSubaccount("unittest-sa", WithExternalName(guidMoved), WithData(v1alpha1.SubaccountParameters{
+					Subdomain: "sub1",
+					Region:    "eu12",
+				})),
+				mockAPIClient: &MockSubaccountClient{
+					returnSubaccounts: &accountclient.ResponseCollection{
+						Value: []accountclient.SubaccountResponseObject{
+							{Guid: guidOther, Subdomain: "sub1", Region: "eu12", State: "OK"},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
 		"DontUpdateEmptyDescription": {
 			reason: "Empty description should NOT require Update",
 			args: args{
@@ -110,11 +229,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -167,11 +288,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -224,11 +347,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -281,11 +406,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -338,11 +465,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -395,11 +524,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -456,11 +587,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -515,11 +648,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -574,11 +709,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -633,11 +770,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -693,11 +832,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -753,11 +894,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -810,11 +953,13 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
 				},
 				crChanges: func(cr *v1alpha1.Subaccount) {
+					meta.SetExternalName(cr, "123")
 					cr.Status.AtProvider.SubaccountGuid = internal.Ptr("123")
 					cr.Status.AtProvider.Status = internal.Ptr("OK")
 					cr.Status.AtProvider.Region = internal.Ptr("eu12")
@@ -864,6 +1009,65 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestLateInitialize(t *testing.T) {
+	observed := &accountclient.SubaccountResponseObject{
+		DisplayName:       "observed",
+		Description:       "observed description",
+		Subdomain:         "sub1",
+		Region:            "eu12",
+		Labels:            &map[string][]string{v1alpha1.SubaccountOperatorLabel: {"uid"}},
+		UsedForProduction: "NOT_USED_FOR_PRODUCTION",
+		ParentGUID:        "ga",
+		GlobalAccountGUID: "ga",
+	}
+	tests := map[string]struct {
+		params v1alpha1.SubaccountParameters
+		want   v1alpha1.SubaccountParameters
+		wantLI bool
+	}{
+		"BackFillUnset": {
+			params: v1alpha1.SubaccountParameters{UsedForProduction: "UNSET"},
+			want: v1alpha1.SubaccountParameters{
+				DisplayName:       "observed",
+				Description:       "observed description",
+				Subdomain:         "sub1",
+				Region:            "eu12",
+				UsedForProduction: "NOT_USED_FOR_PRODUCTION",
+			},
+			wantLI: true,
+		},
+		"KeepSet": {
+			params: v1alpha1.SubaccountParameters{
+				DisplayName:       "desired",
+				Description:       "desired description",
+				Subdomain:         "sub1",
+				Region:            "eu12",
+				UsedForProduction: "USED_FOR_PRODUCTION",
+			},
+			want: v1alpha1.SubaccountParameters{
+				DisplayName:       "desired",
+				Description:       "desired description",
+				Subdomain:         "sub1",
+				Region:            "eu12",
+				UsedForProduction: "USED_FOR_PRODUCTION",
+			},
+			wantLI: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := tc.params
+			li := lateInitialize(&params, observed)
+			if li != tc.wantLI {
+				t.Errorf("lateInitialize(...): want %v, got %v", tc.wantLI, li)
+			}
+			if diff := cmp.Diff(tc.want, params); diff != "" {
+				t.Errorf("lateInitialize(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type args struct {
 		cr         resource.Managed
@@ -930,7 +1134,8 @@ func TestCreate(t *testing.T) {
 					Status:         internal.Ptr("Success"),
 					ParentGuid:     internal.Ptr(""),
 				}),
-					WithConditions(xpv1.Creating())),
+					WithConditions(xpv1.Creating()),
+					WithExternalName("123")),
 				o: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}},
 			},
 		},
@@ -955,6 +1160,7 @@ func TestCreate(t *testing.T) {
 					}),
 					WithConditions(xpv1.Creating()),
 					WithData(v1alpha1.SubaccountParameters{DirectoryGuid: "234"}),
+					WithExternalName("123"),
 				),
 				o: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}},
 			},
@@ -1164,7 +1370,7 @@ func TestDelete(t *testing.T) {
 		"DeleteSuccess": {
 			reason: "Deletion should be successful",
 			args: args{
-				cr: NewSubaccount("unittest-sa", WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
+				cr: NewSubaccount("unittest-sa", WithForceDeletion(), WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
 				mockClient: &MockSubaccountClient{
 					returnSubaccount: &accountclient.SubaccountResponseObject{Guid: "123"},
 					mockDeleteSubaccountExecute: func(r accountclient.ApiDeleteSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
@@ -1180,7 +1386,7 @@ func TestDelete(t *testing.T) {
 		"DeleteAPI404": {
 			reason: "Deletion should be successful if subaccount not found",
 			args: args{
-				cr: NewSubaccount("unittest-sa", WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
+				cr: NewSubaccount("unittest-sa", WithForceDeletion(), WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
 				mockClient: &MockSubaccountClient{
 					returnSubaccount: &accountclient.SubaccountResponseObject{Guid: "123"},
 					mockDeleteSubaccountExecute: func(r accountclient.ApiDeleteSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
@@ -1196,7 +1402,7 @@ func TestDelete(t *testing.T) {
 		"DeleteAPIError": {
 			reason: "Deletion should fail if API returns error",
 			args: args{
-				cr: NewSubaccount("unittest-sa", WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
+				cr: NewSubaccount("unittest-sa", WithForceDeletion(), WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
 				mockClient: &MockSubaccountClient{
 					returnSubaccount: &accountclient.SubaccountResponseObject{Guid: "123"},
 					mockDeleteSubaccountExecute: func(r accountclient.ApiDeleteSubaccountRequest) (*accountclient.SubaccountResponseObject, *http.Response, error) {
@@ -1209,10 +1415,34 @@ func TestDelete(t *testing.T) {
 				err: errors.New("deletion of subaccount failed: apiError"),
 			},
 		},
+		"DeletionProtected": {
+			reason: "Deletion should be refused if deletion protection is enabled",
+			args: args{
+				cr: NewSubaccount("unittest-sa",
+					WithData(v1alpha1.SubaccountParameters{DeletionProtection: true}),
+					WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
+				mockClient: &MockSubaccountClient{},
+				tracker:    trackingtest.NoOpReferenceResolverTracker{},
+			},
+			want: want{
+				err: errors.New(errDeletionProtected),
+			},
+		},
+		"PreDeleteCheckMissing": {
+			reason: "Deletion should be refused by default if no preDeleteCheck is configured",
+			args: args{
+				cr:         NewSubaccount("unittest-sa", WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
+				mockClient: &MockSubaccountClient{},
+				tracker:    trackingtest.NoOpReferenceResolverTracker{},
+			},
+			want: want{
+				err: errors.New(errPreDeleteCheckMissing),
+			},
+		},
 		"TrackerBlocked": {
 			reason: "Deletion should be blocked if tracker is blocked",
 			args: args{
-				cr: NewSubaccount("unittest-sa",
+				cr: NewSubaccount("unittest-sa", WithForceDeletion(),
 					WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")}),
 					WithStatus(v1alpha1.SubaccountObservation{Status: internal.Ptr("DELETING")})),
 				mockClient: &MockSubaccountClient{
@@ -1226,6 +1456,24 @@ func TestDelete(t *testing.T) {
 				err: errors.New("Resource cannot be deleted, still has usages"),
 			},
 		},
+		"TrackerBlockedAfterPreDeleteCheck": {
+			reason: "The preDeleteCheck should not be repeated while the subaccount is in use once it has passed",
+			args: args{
+				cr: NewSubaccount("unittest-sa",
+					WithData(v1alpha1.SubaccountParameters{PreDeleteCheck: &v1alpha1.SubaccountPreDeleteCheck{
+						CloudManagementSecretRef: &xpv1.SecretReference{Name: "cis", Namespace: "default"},
+					}}),
+					WithConditions(v1alpha1.DeletionNotBlocked()),
+					WithStatus(v1alpha1.SubaccountObservation{SubaccountGuid: internal.Ptr("123")})),
+				mockClient: &MockSubaccountClient{},
+				tracker: trackingtest.NoOpReferenceResolverTracker{
+					IsResourceBlocked: true,
+				},
+			},
+			want: want{
+				err: errors.New("Resource cannot be deleted, still has usages"),
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -1396,9 +1644,16 @@ func TestUpdate(t *testing.T) {
 					WithStatus(v1alpha1.SubaccountObservation{
 						SubaccountGuid: internal.Ptr("123"),
 						ParentGuid:     internal.Ptr("234"),
+						Move: &v1alpha1.SubaccountMove{
+							Phase:      v1alpha1.SubaccountMoveFailed,
+							SourceGuid: "234",
+							TargetGuid: "345",
+							Message:    "apiError",
+						},
 					})),
-				o:   managed.ExternalUpdate{},
-				err: errors.New("apiError"),
+				o:               managed.ExternalUpdate{},
+				err:             errors.New("apiError"),
+				moveTargetParam: "345",
 			},
 		},
 		"MoveAccountDirectorySuccess": {
@@ -1425,6 +1680,11 @@ func TestUpdate(t *testing.T) {
 						SubaccountGuid:    internal.Ptr("123"),
 						GlobalAccountGUID: internal.Ptr("global-123"),
 						ParentGuid:        internal.Ptr("global-123"),
+						Move: &v1alpha1.SubaccountMove{
+							Phase:      v1alpha1.SubaccountMoving,
+							SourceGuid: "global-123",
+							TargetGuid: "dir-123",
+						},
 					})),
 				o:               managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}},
 				moveTargetParam: "dir-123",
@@ -1454,6 +1714,11 @@ func TestUpdate(t *testing.T) {
 						SubaccountGuid:    internal.Ptr("123"),
 						GlobalAccountGUID: internal.Ptr("global-123"),
 						ParentGuid:        internal.Ptr("dir-123"),
+						Move: &v1alpha1.SubaccountMove{
+							Phase:      v1alpha1.SubaccountMoving,
+							SourceGuid: "dir-123",
+							TargetGuid: "global-123",
+						},
 					})),
 				o:               managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}},
 				moveTargetParam: "global-123",
@@ -1494,6 +1759,7 @@ func TestUpdate(t *testing.T) {
 					},
 				},
 				accountsAccessor: tc.args.mockAccessor,
+				moves:            newMoveBatcher(0),
 			}
 			got, err := ctrl.Update(context.Background(), tc.args.cr)
 			if contained := testutils.ContainsError(err, tc.want.err); !contained {
@@ -1502,9 +1768,12 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, cmpopts.IgnoreFields(v1alpha1.SubaccountMove{}, "StartTime")); diff != "" {
 				t.Errorf("\n%s\ne.Update(...): -want cr, +got cr:\n%s\n", tc.reason, diff)
 			}
+			if accessor, ok := tc.args.mockAccessor.(*MockAccountsApiAccessor); ok && accessor.LastMoveTarget != tc.want.moveTargetParam {
+				t.Errorf("\n%s\ne.Update(...): want move target %q, got %q\n", tc.reason, tc.want.moveTargetParam, accessor.LastMoveTarget)
+			}
 		})
 	}
 }
@@ -1527,6 +1796,24 @@ func WithStatus(status v1alpha1.SubaccountObservation) SubaccountModifier {
 	}
 }
 
+func WithExternalName(name string) SubaccountModifier {
+	return func(r *v1alpha1.Subaccount) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func WithManagementPolicies(p ...xpv1.ManagementAction) SubaccountModifier {
+	return func(r *v1alpha1.Subaccount) {
+		r.SetManagementPolicies(p)
+	}
+}
+
+func WithForceDeletion() SubaccountModifier {
+	return func(r *v1alpha1.Subaccount) {
+		r.Spec.ForProvider.PreDeleteCheck = &v1alpha1.SubaccountPreDeleteCheck{Policy: v1alpha1.PreDeleteCheckForce}
+	}
+}
+
 func WithData(data v1alpha1.SubaccountParameters) SubaccountModifier {
 	return func(r *v1alpha1.Subaccount) {
 		r.Spec.ForProvider = data
@@ -7,24 +7,28 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/namespaced/v1alpha1"
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
-	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 )
 
-// Setup adds a controller that reconciles GlobalAccount managed resources.
+// Setup adds a controller that reconciles Subaccount managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	return providerconfig.DefaultSetup(mgr, o, &apisv1alpha1.Subaccount{}, apisv1alpha1.SubaccountGroupKind, apisv1alpha1.SubaccountGroupVersionKind, func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
-		return &connector{
-			kube: mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(
-				mgr.GetClient(),
-				&providerv1alpha1.ProviderConfigUsage{},
-			),
-			newServiceFn:    btp.NewBTPClient,
-			resourcetracker: resourcetracker,
-		}
-	})
+	return providerconfig.DefaultSetup(mgr, o, &apisv1alpha1.Subaccount{}, apisv1alpha1.SubaccountGroupKind, apisv1alpha1.SubaccountGroupVersionKind, newConnector)
+}
+
+// SetupNamespaced adds a controller that reconciles namespaced Subaccount managed resources.
+func SetupNamespaced(mgr ctrl.Manager, o controller.Options) error {
+	return providerconfig.NamespacedSetup(mgr, o, &namespacedv1alpha1.Subaccount{}, namespacedv1alpha1.SubaccountGroupKind, namespacedv1alpha1.SubaccountGroupVersionKind, newConnector)
+}
+
+func newConnector(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
+	return &connector{
+		kube:            kube,
+		usage:           usage,
+		newServiceFn:    newServiceFn,
+		resourcetracker: resourcetracker,
+	}
 }
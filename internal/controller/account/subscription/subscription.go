@@ -87,7 +87,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errGet
 	}
 
-	svc, errInit := c.newServiceFn(ctx, creds)
+	svc, errInit := c.newServiceFn(btp.ContextWithProviderConfigName(ctx, providerConfigName(cr)), creds)
 	if errInit != nil {
 		return nil, errInit
 	}
@@ -99,6 +99,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}, nil
 }
 
+func providerConfigName(cr *v1alpha1.Subscription) string {
+	if ref := cr.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
 func (c *connector) loadSecret(ctx context.Context, name string, namespace string) (map[string][]byte, error) {
 	if name == "" || namespace == "" {
 		return nil, errors.New(errExtractSecretKey)
@@ -0,0 +1,130 @@
+package providerconfig
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+)
+
+// defaultPollInterval is used for errors that won't resolve themselves, if the controller options have no poll interval.
+const defaultPollInterval = time.Minute
+
+// apiErrors remembers the last API error of each managed resource during its reconcile.
+type apiErrors struct {
+	errs sync.Map
+}
+
+func (a *apiErrors) record(mg resource.Managed, err error) {
+	key := client.ObjectKeyFromObject(mg)
+	apiErr, ok := apierror.From(err)
+	if !ok {
+		a.errs.Delete(key)
+		if err == nil && mg.GetCondition(providerv1alpha1.APIErrorCondition).Reason != "" {
+			mg.SetConditions(providerv1alpha1.APIErrorResolved())
+		}
+		return
+	}
+	a.errs.Store(key, apiErr)
+	mg.SetConditions(providerv1alpha1.APIError(string(apiErr.Class()), err.Error()))
+}
+
+func (a *apiErrors) take(key types.NamespacedName) (*apierror.APIError, bool) {
+	v, ok := a.errs.LoadAndDelete(key)
+	if !ok {
+		return nil, false
+	}
+	return v.(*apierror.APIError), true
+}
+
+// apiErrorConnector reports the class of BTP API errors returned by the external clients in the APIError condition.
+type apiErrorConnector struct {
+	inner managed.ExternalConnecter
+	errs  *apiErrors
+}
+
+func (c *apiErrorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.inner.Connect(ctx, mg)
+	if err != nil {
+		c.errs.record(mg, err)
+		return nil, err
+	}
+	return &apiErrorExternal{inner: ext, errs: c.errs}, nil
+}
+
+type apiErrorExternal struct {
+	inner managed.ExternalClient
+	errs  *apiErrors
+}
+
+func (e *apiErrorExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.inner.Observe(ctx, mg)
+	e.errs.record(mg, err)
+	return obs, err
+}
+
+func (e *apiErrorExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	creation, err := e.inner.Create(ctx, mg)
+	e.errs.record(mg, err)
+	return creation, err
+}
+
+func (e *apiErrorExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	update, err := e.inner.Update(ctx, mg)
+	e.errs.record(mg, err)
+	return update, err
+}
+
+func (e *apiErrorExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.inner.Delete(ctx, mg)
+	e.errs.record(mg, err)
+	return err
+}
+
+// apiErrorReconciler replaces the exponential backoff of the managed reconciler after API errors, where the error tells better:
+// rate limited requests are retried once the API allows it again and errors that won't resolve themselves are retried with the poll interval.
+type apiErrorReconciler struct {
+	inner        reconcile.Reconciler
+	errs         *apiErrors
+	pollInterval time.Duration
+}
+
+func (r *apiErrorReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.inner.Reconcile(ctx, req)
+	apiErr, ok := r.errs.take(req.NamespacedName)
+	if !ok || err != nil || !result.Requeue {
+		return result, err
+	}
+	class := apiErr.Class()
+	switch {
+	case class == apierror.RateLimited && apiErr.RetryAfter > 0:
+		return reconcile.Result{RequeueAfter: apiErr.RetryAfter}, nil
+	case !class.Retryable():
+		return reconcile.Result{RequeueAfter: r.pollInterval}, nil
+	default:
+		return result, nil
+	}
+}
+
+// withAPIErrors decorates connector and reconciler of a managed resource controller to report and requeue BTP API errors by class.
+func withAPIErrors(pollInterval time.Duration) (func(managed.ExternalConnecter) managed.ExternalConnecter, func(reconcile.Reconciler) reconcile.Reconciler) {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	errs := &apiErrors{}
+	connector := func(inner managed.ExternalConnecter) managed.ExternalConnecter {
+		return &apiErrorConnector{inner: inner, errs: errs}
+	}
+	reconciler := func(inner reconcile.Reconciler) reconcile.Reconciler {
+		return &apiErrorReconciler{inner: inner, errs: errs, pollInterval: pollInterval}
+	}
+	return connector, reconciler
+}
@@ -0,0 +1,98 @@
+package providerconfig
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+)
+
+func TestAPIErrors(t *testing.T) {
+	tests := map[string]struct {
+		err           error
+		result        reconcile.Result
+		wantResult    reconcile.Result
+		wantReason    xpv1.ConditionReason
+		wantCondition corev1.ConditionStatus
+	}{
+		"RateLimited": {
+			err:           &apierror.APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 30 * time.Second},
+			result:        reconcile.Result{Requeue: true},
+			wantResult:    reconcile.Result{RequeueAfter: 30 * time.Second},
+			wantReason:    xpv1.ConditionReason(apierror.RateLimited),
+			wantCondition: corev1.ConditionTrue,
+		},
+		"NotRetryable": {
+			err:           &apierror.APIError{StatusCode: http.StatusForbidden},
+			result:        reconcile.Result{Requeue: true},
+			wantResult:    reconcile.Result{RequeueAfter: time.Minute},
+			wantReason:    xpv1.ConditionReason(apierror.Unauthorized),
+			wantCondition: corev1.ConditionTrue,
+		},
+		"Transient": {
+			err:           &apierror.APIError{StatusCode: http.StatusServiceUnavailable},
+			result:        reconcile.Result{Requeue: true},
+			wantResult:    reconcile.Result{Requeue: true},
+			wantReason:    xpv1.ConditionReason(apierror.Transient),
+			wantCondition: corev1.ConditionTrue,
+		},
+		"OtherError": {
+			err:           errors.New("no API error"),
+			result:        reconcile.Result{Requeue: true},
+			wantResult:    reconcile.Result{Requeue: true},
+			wantCondition: corev1.ConditionUnknown,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "mg"}}
+			connector, reconciler := withAPIErrors(time.Minute)
+			r := reconciler(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				ext, err := connector(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+					return &managed.ExternalClientFns{ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.err
+					}}, nil
+				})).Connect(ctx, mg)
+				assert.NoError(t, err)
+				_, err = ext.Observe(ctx, mg)
+				assert.Equal(t, tc.err, err)
+				return tc.result, nil
+			}))
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "mg"}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			assert.Equal(t, tc.wantReason, mg.GetCondition(v1alpha1.APIErrorCondition).Reason)
+			assert.Equal(t, tc.wantCondition, mg.GetCondition(v1alpha1.APIErrorCondition).Status)
+		})
+	}
+}
+
+func TestAPIErrorsResolved(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "mg"}}
+	errs := &apiErrors{}
+
+	errs.record(mg, nil)
+	assert.Equal(t, corev1.ConditionUnknown, mg.GetCondition(v1alpha1.APIErrorCondition).Status, "expected no condition without previous error")
+
+	errs.record(mg, &apierror.APIError{StatusCode: http.StatusConflict})
+	errs.record(mg, nil)
+	assert.Equal(t, v1alpha1.APIErrorResolvedReason, mg.GetCondition(v1alpha1.APIErrorCondition).Reason)
+	_, ok := errs.take(types.NamespacedName{Name: "mg"})
+	assert.False(t, ok, "expected resolved errors to be forgotten")
+}
@@ -1,9 +1,15 @@
 package providerconfig
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
@@ -21,19 +27,28 @@ import (
 )
 
 const (
-	errGetPC              = "cannot get ProviderConfig"
-	errGetCISCreds        = "cannot get CIS credentials"
-	errGetCFCreds         = "cannot get Service Account credentials"
-	errTrackRUsage        = "cannot track ResourceUsage"
-	errTrackPCUsage       = "cannot track ProviderConfig usage"
-	errNewClient          = "cannot create new Service"
-	errCisSecretEmpty     = "CIS Secret is empty or nil, please check config & secrets referenced in provider config"
-	errCisSecretCorrupted = "CIS Secret does not match expected format"
-	errCFSecretEmpty      = "CF Secret is empty or nil, please check config & secrets referenced in provider config"
+	errGetPC                   = "cannot get ProviderConfig"
+	errGetCISCreds             = "cannot get CIS credentials"
+	errGetCFCreds              = "cannot get Service Account credentials"
+	errTrackRUsage             = "cannot track ResourceUsage"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errNewClient               = "cannot create new Service"
+	errCisSecretEmpty          = "CIS Secret is empty or nil, please check config & secrets referenced in provider config"
+	errCisSecretCorrupted      = "CIS Secret does not match expected format"
+	errCFSecretEmpty           = "CF Secret is empty or nil, please check config & secrets referenced in provider config"
+	errCisSourceUnsupported    = "CIS credentials source %s is not supported"
+	errWorkloadIdentityMissing = "CIS credentials source InjectedIdentity requires workloadIdentity in the provider config"
 )
 
+// defaultServiceAccountTokenPath is used if the workload identity of a ProviderConfig does not configure a token path
+const defaultServiceAccountTokenPath = "/var/run/secrets/btp/serviceaccount/token"
+
+// clientCache shares btp clients between all managed resources referencing the same ProviderConfig.
+var clientCache = btp.NewClientCache()
+
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage, a controller that checks their credentials and
+// a controller accounting for the usage of NamespacedProviderConfigs.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := providerconfig.ControllerName(v1alpha1.ProviderConfigGroupKind)
 
@@ -48,6 +63,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 	)
 
+	if err := setupHealthCheck(mgr, o); err != nil {
+		return err
+	}
+	if err := setupNamespaced(mgr, o); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
@@ -79,9 +101,20 @@ func CreateClient(
 		return nil, errors.Wrap(err, errTrackRUsage)
 	}
 
+	CISSecretData, ServiceAccountSecretData, err := loadCredentials(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := cachedClient(pc, CISSecretData, ServiceAccountSecretData, newServiceFn)
+	return svc, errors.Wrap(err, errNewClient)
+}
+
+// loadCredentials resolves the CIS and service account credentials referenced by the ProviderConfig.
+func loadCredentials(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]byte, []byte, error) {
 	CISSecretData, cisErr := loadCisCredentials(ctx, kube, pc)
 	if cisErr != nil {
-		return nil, cisErr
+		return nil, nil, cisErr
 	}
 
 	cd := pc.Spec.ServiceAccountSecret
@@ -92,24 +125,49 @@ func CreateClient(
 		cd.CommonCredentialSelectors,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, errGetCFCreds)
+		return nil, nil, errors.Wrap(err, errGetCFCreds)
 	}
 	if ServiceAccountSecretData == nil {
-		return nil, errors.New(errCFSecretEmpty)
+		return nil, nil, errors.New(errCFSecretEmpty)
 
 	}
+	return CISSecretData, ServiceAccountSecretData, nil
+}
 
-	svc, err := newServiceFn(CISSecretData, ServiceAccountSecretData)
-	return svc, errors.Wrap(err, errNewClient)
+// cachedClient returns the shared client of the ProviderConfig, any change to the ProviderConfig spec or the referenced secrets results in a new client
+func cachedClient(
+	pc *v1alpha1.ProviderConfig,
+	CISSecretData []byte,
+	ServiceAccountSecretData []byte,
+	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error),
+) (*btp.Client, error) {
+	fingerprint := btp.Fingerprint([]byte(strconv.FormatInt(pc.GetGeneration(), 10)), CISSecretData, ServiceAccountSecretData)
+	return clientCache.Get(pc.GetUID(), fingerprint, func() (*btp.Client, error) {
+		var svc *btp.Client
+		var err error
+		if pc.Spec.CISSecret.Source == xpv1.CredentialsSourceInjectedIdentity {
+			svc, err = newWorkloadIdentityClient(pc, ServiceAccountSecretData)
+		} else {
+			svc, err = newServiceFn(CISSecretData, ServiceAccountSecretData)
+		}
+		if err == nil {
+			svc.SetProviderConfigName(pc.GetName())
+		}
+		return svc, err
+	})
 }
 
+// ResolveProviderConfig returns the ProviderConfig referenced by mg, namespaced resources reference a NamespacedProviderConfig of their namespace.
 func ResolveProviderConfig(ctx context.Context, mg resource.Managed, kube client.Client) (*v1alpha1.ProviderConfig, error) {
+	if mg.GetNamespace() != "" {
+		return resolveNamespacedProviderConfig(ctx, mg, kube)
+	}
 	pc := &v1alpha1.ProviderConfig{}
 	err := kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc)
 	return pc, err
 }
 
-// Resolves CIS credential secret to unified json string format
+// Resolves CIS credentials from the configured source to unified json string format
 // Supports two formats:
 //   - our own format:
 //     data:
@@ -121,8 +179,42 @@ func ResolveProviderConfig(ctx context.Context, mg resource.Managed, kube client
 //     data:
 //     data: //(key as defined in providerconfig ref)
 //     {"endpoints": {...}, "uaa": {...}, "grant_type": "client_credentials", ...
+//
+// Both formats may contain x509 bindings, which carry certificate and key inside the uaa json instead of a clientsecret.
+// Environment variables and files contain the json of either format, a directory (e.g. mounted by a CSI secret driver)
+// is read like a btp service operator secret with one file per key.
+// InjectedIdentity results in credentials without secret, that exchange the service account token of the provider (workload identity).
 func loadCisCredentials(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]byte, error) {
 	cd := pc.Spec.CISSecret
+	switch cd.Source { //nolint:exhaustive
+	case xpv1.CredentialsSourceSecret:
+		return loadCisSecret(ctx, kube, cd)
+	case xpv1.CredentialsSourceEnvironment:
+		data, err := resource.ExtractEnv(ctx, os.Getenv, cd.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCISCreds)
+		}
+		return normalizedCisCredentials(data)
+	case xpv1.CredentialsSourceFilesystem:
+		return loadCisFilesystem(cd)
+	case xpv1.CredentialsSourceInjectedIdentity:
+		credential, err := workloadIdentityCISCredential(pc.Spec.WorkloadIdentity)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(credential)
+	case xpv1.CredentialsSourceNone:
+		return nil, errors.New(errCisSecretEmpty)
+	default:
+		return nil, errors.Errorf(errCisSourceUnsupported, cd.Source)
+	}
+}
+
+// loadCisSecret reads the CIS credentials from the referenced kubernetes secret
+func loadCisSecret(ctx context.Context, kube client.Client, cd v1alpha1.ProviderCredentials) ([]byte, error) {
+	if cd.SecretRef == nil {
+		return nil, errors.New(errCisSecretEmpty)
+	}
 	var secret corev1.Secret
 
 	if findErr := kube.Get(ctx,
@@ -144,6 +236,106 @@ func loadCisCredentials(ctx context.Context, kube client.Client, pc *v1alpha1.Pr
 	}
 }
 
+// loadCisFilesystem reads the CIS credentials from a single file or from a directory with one file per key
+func loadCisFilesystem(cd v1alpha1.ProviderCredentials) ([]byte, error) {
+	if cd.Fs == nil || cd.Fs.Path == "" {
+		return nil, errors.New(errCisSecretEmpty)
+	}
+	info, err := os.Stat(cd.Fs.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCISCreds)
+	}
+	if !info.IsDir() {
+		data, err := os.ReadFile(filepath.Clean(cd.Fs.Path))
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCISCreds)
+		}
+		return normalizedCisCredentials(data)
+	}
+
+	entries, err := os.ReadDir(cd.Fs.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCISCreds)
+	}
+	data := map[string][]byte{}
+	for _, entry := range entries {
+		// kubernetes volumes keep the actual files in hidden ..data directories and link them into the mount
+		if strings.HasPrefix(entry.Name(), "..") {
+			continue
+		}
+		path := filepath.Join(cd.Fs.Path, entry.Name())
+		if fileInfo, err := os.Stat(path); err != nil || fileInfo.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCISCreds)
+		}
+		data[entry.Name()] = content
+	}
+	if len(data) == 0 {
+		return nil, errors.New(errCisSecretEmpty)
+	}
+	toBytes, err := decodedBtpOperatorSecret(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errCisSecretCorrupted)
+	}
+	return toBytes, nil
+}
+
+// workloadIdentityCISCredential builds the CIS credentials for exchanging the projected service account token of the provider.
+// Workload identity is only configured by the spec of a ProviderConfig, never by a secret, as the token file is read and sent to the authentication url.
+func workloadIdentityCISCredential(wi *v1alpha1.WorkloadIdentity) (btp.CISCredential, error) {
+	if wi == nil {
+		return btp.CISCredential{}, errors.New(errWorkloadIdentityMissing)
+	}
+	tokenPath := wi.TokenPath
+	if tokenPath == "" {
+		tokenPath = defaultServiceAccountTokenPath
+	}
+	credential := btp.NewWorkloadIdentityCISCredential(wi.ClientID, wi.AuthenticationURL, tokenPath)
+	credential.Endpoints.AccountsServiceUrl = wi.Endpoints.AccountsServiceURL
+	credential.Endpoints.EntitlementsServiceUrl = wi.Endpoints.EntitlementsServiceURL
+	credential.Endpoints.ProvisioningServiceUrl = wi.Endpoints.ProvisioningServiceURL
+	credential.Endpoints.SaasRegistryServiceUrl = wi.Endpoints.SaasRegistryServiceURL
+	return credential, nil
+}
+
+// newWorkloadIdentityClient creates the client of a ProviderConfig with source InjectedIdentity
+func newWorkloadIdentityClient(pc *v1alpha1.ProviderConfig, ServiceAccountSecretData []byte) (*btp.Client, error) {
+	credential, err := workloadIdentityCISCredential(pc.Spec.WorkloadIdentity)
+	if err != nil {
+		return nil, err
+	}
+	return btp.NewWorkloadIdentityClient(credential, ServiceAccountSecretData)
+}
+
+// normalizedCisCredentials unifies json read from environment or file, attributes containing stringified json
+// (as in btp service operator secrets rendered to json) are unpacked to nested objects
+func normalizedCisCredentials(data []byte) ([]byte, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errors.New(errCisSecretEmpty)
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, errors.Wrap(err, errCisSecretCorrupted)
+	}
+	unpacked := make(map[string][]byte, len(attributes))
+	for k, v := range attributes {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			unpacked[k] = []byte(s)
+		} else {
+			unpacked[k] = v
+		}
+	}
+	toBytes, err := decodedBtpOperatorSecret(unpacked)
+	if err != nil {
+		return nil, errors.Wrap(err, errCisSecretCorrupted)
+	}
+	return toBytes, nil
+}
+
 // decodes btp service operator generated format from map of byte slices to stringified json
 func decodedBtpOperatorSecret(data map[string][]byte) ([]byte, error) {
 	var unpackedData = map[string]interface{}{}
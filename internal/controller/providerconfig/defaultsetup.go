@@ -35,10 +35,11 @@ func DefaultSetup(mgr ctrl.Manager, o controller.Options, object client.Object,
 			mgr.GetClient(),
 			&providerv1alpha1.ProviderConfigUsage{},
 		)
+	withAPIErrorsConnector, withAPIErrorsReconciler := withAPIErrors(o.PollInterval)
 	r := managed.NewReconciler(
 		mgr,
 		resource.ManagedKind(gvk),
-		managed.WithExternalConnecter(connectorFn(mgr.GetClient(), usageTracker, referenceTracker, btp.NewBTPClient)),
+		managed.WithExternalConnecter(withAPIErrorsConnector(connectorFn(mgr.GetClient(), usageTracker, referenceTracker, btp.NewBTPClient))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		connectionPublishers(mgr, o),
@@ -50,7 +51,7 @@ func DefaultSetup(mgr ctrl.Manager, o controller.Options, object client.Object,
 		WithOptions(o.ForControllerRuntime()).
 		For(object).
 		WithEventFilter(resource.DesiredStateChanged()).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, withAPIErrorsReconciler(r), o.GlobalRateLimiter))
 }
 
 func connectionPublishers(mgr ctrl.Manager, o controller.Options) managed.ReconcilerOption {
@@ -0,0 +1,136 @@
+package providerconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/apierror"
+)
+
+const (
+	errUpdatePCStatus             = "cannot update ProviderConfig status"
+	errHealthCheckToken           = "cannot fetch token with CIS credentials"
+	errHealthCheckGlobalAccount   = "cannot get global account with CIS credentials"
+	errHealthCheckEmptyGuid       = "global account GUID returned by accounts service is empty"
+	errHealthCheckAccountMismatch = "CIS credentials belong to global account %s, but %s is configured"
+)
+
+// healthCheckInterval is the interval in which credentials are checked again, independent of any ProviderConfig change.
+const healthCheckInterval = 10 * time.Minute
+
+// setupHealthCheck adds a controller that validates the credentials referenced by ProviderConfigs and reports
+// the result as Ready and Healthy conditions in their status.
+func setupHealthCheck(mgr ctrl.Manager, o controller.Options) error {
+	name := "health/" + providerconfig.ControllerName(v1alpha1.ProviderConfigGroupKind)
+
+	r := &healthReconciler{
+		kube:         mgr.GetClient(),
+		log:          o.Logger.WithValues("controller", name),
+		newServiceFn: btp.NewBTPClient,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProviderConfig{}).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type healthReconciler struct {
+	kube         client.Client
+	log          logging.Logger
+	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)
+}
+
+// Reconcile checks the credentials of the ProviderConfig and updates its status.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pc := &v1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		clientCache.Invalidate(pc.GetUID())
+		return reconcile.Result{}, nil
+	}
+
+	r.check(ctx, pc)
+	r.log.Debug("Checked ProviderConfig credentials", "name", pc.GetName(), "healthy", pc.GetCondition(v1alpha1.TypeHealthy).Status)
+
+	return reconcile.Result{RequeueAfter: healthCheckInterval}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdatePCStatus)
+}
+
+// check validates the referenced secrets, fetches a token and resolves the global account with it.
+func (r *healthReconciler) check(ctx context.Context, pc *v1alpha1.ProviderConfig) {
+	now := metav1.Now()
+	pc.Status.LastHealthCheckTime = &now
+
+	svc, err := r.client(ctx, pc)
+	if err != nil {
+		pc.Status.CredentialType = ""
+		pc.Status.TokenExpiry = nil
+		pc.SetConditions(v1alpha1.CredentialsInvalid(err), v1alpha1.Unhealthy(v1alpha1.ReasonInvalidCredentials, err))
+		return
+	}
+	pc.SetConditions(v1alpha1.CredentialsValid())
+	if svc.Credential != nil {
+		pc.Status.CredentialType = svc.Credential.CredentialType()
+	}
+
+	token, err := svc.Token()
+	if err != nil {
+		pc.Status.TokenExpiry = nil
+		pc.SetConditions(v1alpha1.Unhealthy(v1alpha1.ReasonHealthCheckFailed, errors.Wrap(err, errHealthCheckToken)))
+		return
+	}
+	pc.Status.TokenExpiry = nil
+	if !token.Expiry.IsZero() {
+		expiry := metav1.NewTime(token.Expiry)
+		pc.Status.TokenExpiry = &expiry
+	}
+
+	globalAccount, raw, err := svc.AccountsServiceClient.GlobalAccountOperationsAPI.GetGlobalAccount(ctx).Execute()
+	if err != nil {
+		pc.SetConditions(v1alpha1.Unhealthy(v1alpha1.ReasonHealthCheckFailed, errors.Wrap(apierror.New(err, raw), errHealthCheckGlobalAccount)))
+		return
+	}
+	if globalAccount.Guid == "" {
+		pc.SetConditions(v1alpha1.Unhealthy(v1alpha1.ReasonHealthCheckFailed, errors.New(errHealthCheckEmptyGuid)))
+		return
+	}
+	pc.Status.GlobalAccountGuid = globalAccount.Guid
+	pc.Status.GlobalAccountSubdomain = internal.Val(globalAccount.Subdomain)
+
+	configured := pc.Spec.GlobalAccount
+	if configured != "" && pc.Status.GlobalAccountSubdomain != "" && !strings.EqualFold(configured, pc.Status.GlobalAccountSubdomain) {
+		pc.SetConditions(v1alpha1.Unhealthy(v1alpha1.ReasonHealthCheckFailed, fmt.Errorf(errHealthCheckAccountMismatch, pc.Status.GlobalAccountSubdomain, configured)))
+		return
+	}
+	pc.SetConditions(v1alpha1.Healthy())
+}
+
+func (r *healthReconciler) client(ctx context.Context, pc *v1alpha1.ProviderConfig) (*btp.Client, error) {
+	CISSecretData, ServiceAccountSecretData, err := loadCredentials(ctx, r.kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := cachedClient(pc, CISSecretData, ServiceAccountSecretData, r.newServiceFn)
+	return svc, errors.Wrap(err, errNewClient)
+}
@@ -0,0 +1,157 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	test2 "github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+// fakeCisServer serves the token endpoint and the global account of the accounts service
+func fakeCisServer(t *testing.T, tokenStatus int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			w.WriteHeader(tokenStatus)
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+		case "/accounts/v1/globalAccount":
+			_, _ = w.Write([]byte(`{"guid":"ga-guid","globalAccountGUID":"ga-guid","subdomain":"my-ga","displayName":"GA","description":"",
+				"commercialModel":"Subscription","consumptionBased":false,"createdDate":0,"geoAccess":"STANDARD","licenseType":"SAPDEV",
+				"parentGUID":"ga-guid","parentType":"ROOT"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func cisSecretFor(url string) map[string][]byte {
+	data, _ := json.Marshal(map[string]interface{}{
+		"endpoints":  map[string]string{"accounts_service_url": url, "entitlements_service_url": url, "provisioning_service_url": url},
+		"grant_type": "client_credentials",
+		"uaa":        map[string]string{"clientid": "id", "clientsecret": "secret", "url": url},
+	})
+	return map[string][]byte{"data": data}
+}
+
+func healthMockClient(cisSecret map[string][]byte, globalAccount string, updated *v1alpha1.ProviderConfig) *test2.MockClient {
+	kube := mockClient(cisSecret)
+	get := kube.MockGet
+	kube.MockGet = func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		err := get(ctx, key, obj)
+		if pc, ok := obj.(*v1alpha1.ProviderConfig); ok {
+			pc.SetName(key.Name)
+			pc.Spec.GlobalAccount = globalAccount
+		}
+		return err
+	}
+	kube.MockStatusUpdate = func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+		obj.(*v1alpha1.ProviderConfig).DeepCopyInto(updated)
+		return nil
+	}
+	return kube
+}
+
+func TestHealthReconciler(t *testing.T) {
+	type want struct {
+		ready          corev1.ConditionStatus
+		healthy        corev1.ConditionStatus
+		healthyReason  xpv1.ConditionReason
+		guid           string
+		subdomain      string
+		credentialType string
+		tokenExpiry    bool
+	}
+	cases := map[string]struct {
+		cisSecret     func(url string) map[string][]byte
+		tokenStatus   int
+		globalAccount string
+		want          want
+	}{
+		"Healthy": {
+			cisSecret:     cisSecretFor,
+			tokenStatus:   http.StatusOK,
+			globalAccount: "my-ga",
+			want: want{ready: corev1.ConditionTrue, healthy: corev1.ConditionTrue, healthyReason: v1alpha1.ReasonHealthCheckSucceeded,
+				guid: "ga-guid", subdomain: "my-ga", credentialType: btp.CredentialTypeClientCredentials, tokenExpiry: true},
+		},
+		"GlobalAccountMismatch": {
+			cisSecret:     cisSecretFor,
+			tokenStatus:   http.StatusOK,
+			globalAccount: "other-ga",
+			want: want{ready: corev1.ConditionTrue, healthy: corev1.ConditionFalse, healthyReason: v1alpha1.ReasonHealthCheckFailed,
+				guid: "ga-guid", subdomain: "my-ga", credentialType: btp.CredentialTypeClientCredentials, tokenExpiry: true},
+		},
+		"TokenRejected": {
+			cisSecret:   cisSecretFor,
+			tokenStatus: http.StatusUnauthorized,
+			want: want{ready: corev1.ConditionTrue, healthy: corev1.ConditionFalse, healthyReason: v1alpha1.ReasonHealthCheckFailed,
+				credentialType: btp.CredentialTypeClientCredentials},
+		},
+		"CorruptedSecret": {
+			cisSecret: func(url string) map[string][]byte {
+				return map[string][]byte{"data": []byte("{not json")}
+			},
+			tokenStatus: http.StatusOK,
+			want:        want{ready: corev1.ConditionFalse, healthy: corev1.ConditionFalse, healthyReason: v1alpha1.ReasonInvalidCredentials},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := fakeCisServer(t, tc.tokenStatus)
+			updated := &v1alpha1.ProviderConfig{}
+			r := &healthReconciler{
+				kube:         healthMockClient(tc.cisSecret(server.URL), tc.globalAccount, updated),
+				log:          logging.NewNopLogger(),
+				newServiceFn: btp.NewBTPClient,
+			}
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "pc-" + name}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, healthCheckInterval, result.RequeueAfter)
+			assert.Equal(t, tc.want.ready, updated.GetCondition(xpv1.TypeReady).Status)
+			assert.Equal(t, tc.want.healthy, updated.GetCondition(v1alpha1.TypeHealthy).Status)
+			assert.Equal(t, tc.want.healthyReason, updated.GetCondition(v1alpha1.TypeHealthy).Reason)
+			assert.Equal(t, tc.want.guid, updated.Status.GlobalAccountGuid)
+			assert.Equal(t, tc.want.subdomain, updated.Status.GlobalAccountSubdomain)
+			assert.Equal(t, tc.want.credentialType, updated.Status.CredentialType)
+			assert.Equal(t, tc.want.tokenExpiry, updated.Status.TokenExpiry != nil)
+			assert.NotNil(t, updated.Status.LastHealthCheckTime)
+		})
+	}
+}
+
+func TestHealthReconcilerIgnoresMissingProviderConfig(t *testing.T) {
+	r := &healthReconciler{
+		kube: &test2.MockClient{MockGet: test2.NewMockGetFn(nil, func(obj client.Object) error {
+			return errNotFound()
+		})},
+		log:          logging.NewNopLogger(),
+		newServiceFn: btp.NewBTPClient,
+	}
+	result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "gone"}})
+	assert.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, result)
+}
+
+func errNotFound() error {
+	return kerrors.NewNotFound(corev1.Resource("providerconfigs"), "gone")
+}
@@ -0,0 +1,170 @@
+package providerconfig
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+const (
+	errGetNamespacedPC           = "cannot get NamespacedProviderConfig"
+	errListNamespacedPCUs        = "cannot list NamespacedProviderConfigUsages"
+	errUpdateNamespacedPC        = "cannot update NamespacedProviderConfig"
+	errApplyNamespacedPCU        = "cannot apply NamespacedProviderConfigUsage"
+	errNamespacedSourceSecret    = "NamespacedProviderConfig %s only supports credentials from source Secret"
+	errNamespacedSecretNamespace = "secret %s referenced by NamespacedProviderConfig %s must be located in namespace %s"
+
+	inUseFinalizer = "in-use.crossplane.io"
+	usageShortWait = 30 * time.Second
+)
+
+// resolveNamespacedProviderConfig returns the NamespacedProviderConfig of the namespace of mg as ProviderConfig.
+// Credentials may only be read from secrets of that namespace, other sources would expose credentials of the provider itself.
+func resolveNamespacedProviderConfig(ctx context.Context, mg resource.Managed, kube client.Client) (*v1alpha1.ProviderConfig, error) {
+	npc := &v1alpha1.NamespacedProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetProviderConfigReference().Name}, npc); err != nil {
+		return nil, errors.Wrap(err, errGetNamespacedPC)
+	}
+	pc := npc.ToProviderConfig()
+	for _, cd := range []v1alpha1.ProviderCredentials{pc.Spec.CISSecret, pc.Spec.ServiceAccountSecret} {
+		if cd.Source != xpv1.CredentialsSourceSecret {
+			return nil, errors.Errorf(errNamespacedSourceSecret, npc.GetName())
+		}
+		if cd.SecretRef != nil && cd.SecretRef.Namespace != npc.GetNamespace() {
+			return nil, errors.Errorf(errNamespacedSecretNamespace, cd.SecretRef.Name, npc.GetName(), npc.GetNamespace())
+		}
+	}
+	return pc, nil
+}
+
+// NewUsageTracker tracks the usage of ProviderConfigs by cluster scoped managed resources
+// and of NamespacedProviderConfigs by namespaced managed resources.
+func NewUsageTracker(c client.Client) resource.Tracker {
+	cluster := resource.NewProviderConfigUsageTracker(c, &v1alpha1.ProviderConfigUsage{})
+	applicator := resource.NewAPIUpdatingApplicator(c)
+	return resource.TrackerFn(func(ctx context.Context, mg resource.Managed) error {
+		if mg.GetNamespace() == "" {
+			return cluster.Track(ctx, mg)
+		}
+		return trackNamespacedUsage(ctx, applicator, mg)
+	})
+}
+
+// trackNamespacedUsage creates or updates the NamespacedProviderConfigUsage of mg in its namespace,
+// like the ProviderConfigUsageTracker does for cluster scoped resources.
+func trackNamespacedUsage(ctx context.Context, applicator resource.Applicator, mg resource.Managed) error {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return errors.New(errGetNamespacedPC)
+	}
+	gvk := mg.GetObjectKind().GroupVersionKind()
+
+	pcu := &v1alpha1.NamespacedProviderConfigUsage{}
+	pcu.SetName(string(mg.GetUID()))
+	pcu.SetNamespace(mg.GetNamespace())
+	pcu.SetLabels(map[string]string{xpv1.LabelKeyProviderName: ref.Name})
+	pcu.SetOwnerReferences([]metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, gvk))})
+	pcu.SetProviderConfigReference(xpv1.Reference{Name: ref.Name})
+	pcu.SetResourceReference(xpv1.TypedReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       mg.GetName(),
+	})
+
+	err := applicator.Apply(ctx, pcu,
+		resource.MustBeControllableBy(mg.GetUID()),
+		resource.AllowUpdateIf(func(current, _ runtime.Object) bool {
+			return current.(*v1alpha1.NamespacedProviderConfigUsage).GetProviderConfigReference() != pcu.GetProviderConfigReference()
+		}),
+	)
+	return errors.Wrap(resource.Ignore(resource.IsNotAllowed, err), errApplyNamespacedPCU)
+}
+
+// setupNamespaced adds a controller that accounts for the usages of NamespacedProviderConfigs within their namespace.
+func setupNamespaced(mgr ctrl.Manager, o controller.Options) error {
+	name := providerconfig.ControllerName(v1alpha1.NamespacedProviderConfigGroupKind)
+
+	r := &namespacedReconciler{
+		kube: mgr.GetClient(),
+		log:  o.Logger.WithValues("controller", name),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.NamespacedProviderConfig{}).
+		Watches(&v1alpha1.NamespacedProviderConfigUsage{}, handler.EnqueueRequestsFromMapFunc(usedNamespacedProviderConfig)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func usedNamespacedProviderConfig(_ context.Context, obj client.Object) []reconcile.Request {
+	pcu, ok := obj.(*v1alpha1.NamespacedProviderConfigUsage)
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: pcu.GetNamespace(),
+		Name:      pcu.GetProviderConfigReference().Name,
+	}}}
+}
+
+type namespacedReconciler struct {
+	kube client.Client
+	log  logging.Logger
+}
+
+// Reconcile counts the usages of a NamespacedProviderConfig and blocks its deletion while it is in use.
+func (r *namespacedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	npc := &v1alpha1.NamespacedProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, npc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetNamespacedPC)
+	}
+
+	usages := &v1alpha1.NamespacedProviderConfigUsageList{}
+	if err := r.kube.List(ctx, usages, client.InNamespace(npc.GetNamespace()), client.MatchingLabels{xpv1.LabelKeyProviderName: npc.GetName()}); err != nil {
+		r.log.Debug(errListNamespacedPCUs, "error", err)
+		return reconcile.Result{RequeueAfter: usageShortWait}, nil
+	}
+	users := int64(len(usages.Items))
+
+	if meta.WasDeleted(npc) {
+		clientCache.Invalidate(npc.GetUID())
+		if users > 0 {
+			npc.Status.Users = users
+			npc.Status.SetConditions(providerconfig.Terminating().WithMessage("Blocking deletion while usages still exist"))
+			return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, npc), errUpdatePCStatus)
+		}
+		meta.RemoveFinalizer(npc, inUseFinalizer)
+		if err := r.kube.Update(ctx, npc); err != nil {
+			r.log.Debug(errUpdateNamespacedPC, "error", err)
+			return reconcile.Result{RequeueAfter: usageShortWait}, nil
+		}
+		return reconcile.Result{}, nil
+	}
+
+	meta.AddFinalizer(npc, inUseFinalizer)
+	if err := r.kube.Update(ctx, npc); err != nil {
+		r.log.Debug(errUpdateNamespacedPC, "error", err)
+		return reconcile.Result{RequeueAfter: usageShortWait}, nil
+	}
+	npc.Status.Users = users
+	return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, npc), errUpdatePCStatus)
+}
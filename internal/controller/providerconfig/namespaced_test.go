@@ -0,0 +1,261 @@
+package providerconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	test2 "github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/namespaced/v1alpha1"
+	accountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+func namespacedResource(namespace string) resource.Managed {
+	mg := fakeResource()
+	mg.SetNamespace(namespace)
+	return mg
+}
+
+func namespacedProviderConfigClient(mutate func(npc *v1alpha1.NamespacedProviderConfig)) *test2.MockClient {
+	return &test2.MockClient{MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		npc, ok := obj.(*v1alpha1.NamespacedProviderConfig)
+		if !ok {
+			return errNotFound()
+		}
+		pc := fakeProviderConfig(&v1alpha1.ProviderConfig{})
+		npc.SetName(key.Name)
+		npc.SetNamespace(key.Namespace)
+		npc.Spec = pc.Spec
+		npc.Spec.CISSecret.SecretRef.Namespace = ""
+		npc.Spec.ServiceAccountSecret.SecretRef.Namespace = key.Namespace
+		if mutate != nil {
+			mutate(npc)
+		}
+		return nil
+	}}
+}
+
+func TestResolveNamespacedProviderConfig(t *testing.T) {
+	tests := map[string]struct {
+		mutate  func(npc *v1alpha1.NamespacedProviderConfig)
+		wantErr bool
+	}{
+		"SecretsOfOwnNamespace": {},
+		"SecretOfOtherNamespace": {
+			mutate: func(npc *v1alpha1.NamespacedProviderConfig) {
+				npc.Spec.CISSecret.SecretRef.Namespace = "other-team"
+			},
+			wantErr: true,
+		},
+		"EnvironmentSource": {
+			mutate: func(npc *v1alpha1.NamespacedProviderConfig) {
+				npc.Spec.CISSecret = v1alpha1.ProviderCredentials{
+					Source:                    xpv1.CredentialsSourceEnvironment,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{Env: &xpv1.EnvSelector{Name: "CIS"}},
+				}
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc, err := ResolveProviderConfig(context.Background(), namespacedResource("team-a"), namespacedProviderConfigClient(tc.mutate))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "team-a", pc.GetNamespace())
+			assert.Equal(t, "team-a", pc.Spec.CISSecret.SecretRef.Namespace)
+			assert.Equal(t, "team-a", pc.Spec.ServiceAccountSecret.SecretRef.Namespace)
+		})
+	}
+}
+
+func TestUsageTrackerNamespaced(t *testing.T) {
+	var created client.Object
+	kube := &test2.MockClient{
+		MockGet: test2.NewMockGetFn(errNotFound()),
+		MockCreate: func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+			created = obj
+			return nil
+		},
+	}
+	mg := namespacedResource("team-a")
+	mg.SetUID("uid")
+
+	assert.NoError(t, NewUsageTracker(kube).Track(context.Background(), mg))
+
+	pcu, ok := created.(*v1alpha1.NamespacedProviderConfigUsage)
+	if assert.True(t, ok) {
+		assert.Equal(t, "team-a", pcu.GetNamespace())
+		assert.Equal(t, "uid", pcu.GetName())
+		assert.Equal(t, "any", pcu.GetProviderConfigReference().Name)
+	}
+}
+
+func TestNamespacedReconciler(t *testing.T) {
+	tests := map[string]struct {
+		deleted        bool
+		usages         int
+		wantUsers      int64
+		wantFinalizer  bool
+		wantStatusCall bool
+	}{
+		"InUse":            {usages: 2, wantUsers: 2, wantFinalizer: true, wantStatusCall: true},
+		"DeletionBlocked":  {deleted: true, usages: 1, wantUsers: 1, wantFinalizer: true, wantStatusCall: true},
+		"DeletionUnused":   {deleted: true, wantFinalizer: false},
+		"UnusedNotDeleted": {wantFinalizer: true, wantStatusCall: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var listOpts []client.ListOption
+			saved := &v1alpha1.NamespacedProviderConfig{}
+			statusUpdated := false
+			kube := &test2.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					npc := obj.(*v1alpha1.NamespacedProviderConfig)
+					npc.SetName(key.Name)
+					npc.SetNamespace(key.Namespace)
+					meta.AddFinalizer(npc, inUseFinalizer)
+					if tc.deleted {
+						now := metav1.Now()
+						npc.SetDeletionTimestamp(&now)
+					}
+					return nil
+				},
+				MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					listOpts = opts
+					l := list.(*v1alpha1.NamespacedProviderConfigUsageList)
+					l.Items = make([]v1alpha1.NamespacedProviderConfigUsage, tc.usages)
+					return nil
+				},
+				MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					obj.(*v1alpha1.NamespacedProviderConfig).DeepCopyInto(saved)
+					return nil
+				},
+				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					statusUpdated = true
+					obj.(*v1alpha1.NamespacedProviderConfig).DeepCopyInto(saved)
+					return nil
+				},
+			}
+			r := &namespacedReconciler{kube: kube, log: logging.NewNopLogger()}
+
+			_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "default"}})
+
+			assert.NoError(t, err)
+			assert.Contains(t, listOpts, client.InNamespace("team-a"))
+			assert.Equal(t, tc.wantStatusCall, statusUpdated)
+			assert.Equal(t, tc.wantUsers, saved.Status.Users)
+			assert.Equal(t, tc.wantFinalizer, meta.FinalizerExists(saved, inUseFinalizer))
+		})
+	}
+}
+
+// clusterExternal acts like the external client of a cluster scoped resource
+type clusterExternal struct {
+	observed resource.Managed
+}
+
+func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	e.observed = mg
+	cr := mg.(*accountv1alpha1.Subaccount)
+	cr.Status.AtProvider.SubaccountGuid = &[]string{"guid"}[0]
+	meta.SetExternalName(cr, "guid")
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true}, nil
+}
+
+func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr := mg.(*accountv1alpha1.Subaccount)
+	cr.Status.AtProvider.Job = &accountv1alpha1.AsyncJob{ID: "job"}
+	meta.SetExternalName(cr, "guid")
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
+
+func TestNamespacedExternal(t *testing.T) {
+	inner := &clusterExternal{}
+	connector := &namespacedConnector{inner: managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		assert.Equal(t, "team-a", mg.GetNamespace())
+		assert.Equal(t, namespacedv1alpha1.SubaccountGroupVersionKind, mg.GetObjectKind().GroupVersionKind())
+		return inner, nil
+	})}
+	cr := &namespacedv1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: "team-a"}}
+
+	ext, err := connector.Connect(context.Background(), cr)
+	assert.NoError(t, err)
+	obs, err := ext.Observe(context.Background(), cr)
+
+	assert.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.IsType(t, &accountv1alpha1.Subaccount{}, inner.observed)
+	assert.Equal(t, "guid", meta.GetExternalName(cr))
+	assert.Equal(t, "guid", *cr.Status.AtProvider.SubaccountGuid)
+	assert.Equal(t, xpv1.Available().Reason, cr.GetCondition(xpv1.TypeReady).Reason)
+
+	_, err = (&namespacedConnector{}).Connect(context.Background(), &fake.Managed{})
+	assert.Error(t, err)
+}
+
+func TestNamespacedExternalCreateSavesStatus(t *testing.T) {
+	var saved client.Object
+	kube := &test2.MockClient{MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+		saved = obj
+		obj.SetResourceVersion("2")
+		obj.SetAnnotations(nil)
+		return nil
+	}}
+	ext := &namespacedExternal{kube: kube, inner: &clusterExternal{}}
+	cr := &namespacedv1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: "team-a", ResourceVersion: "1"}}
+
+	_, err := ext.Create(context.Background(), cr)
+
+	assert.NoError(t, err)
+	assert.IsType(t, &namespacedv1alpha1.Subaccount{}, saved)
+	assert.Equal(t, "team-a", saved.GetNamespace())
+	assert.Equal(t, "job", saved.(*namespacedv1alpha1.Subaccount).Status.AtProvider.Job.ID)
+	assert.Equal(t, "2", cr.GetResourceVersion())
+	assert.Equal(t, "guid", meta.GetExternalName(cr))
+}
+
+func TestNamespacedConnectionPublisher(t *testing.T) {
+	published := false
+	p := &namespacedConnectionPublisher{publisher: managed.ConnectionPublisherFns{
+		PublishConnectionFn: func(ctx context.Context, o resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+			published = true
+			return true, nil
+		},
+	}}
+	cr := &namespacedv1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: "team-a"}}
+
+	cr.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "creds", Namespace: "other-team"})
+	_, err := p.PublishConnection(context.Background(), cr, nil)
+	assert.Error(t, err)
+	assert.False(t, published)
+
+	cr.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "creds", Namespace: "team-a"})
+	_, err = p.PublishConnection(context.Background(), cr, nil)
+	assert.NoError(t, err)
+	assert.True(t, published)
+}
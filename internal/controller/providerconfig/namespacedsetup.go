@@ -0,0 +1,200 @@
+package providerconfig
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/features"
+)
+
+const (
+	errNotClusterScopedConvertible = "managed resource is not a namespaced resource with cluster scoped counterpart"
+	errConnectionSecretNamespace   = "connection secret must be written to namespace %s of the managed resource"
+	errSaveCreationStatus          = "cannot save status of namespaced resource after creation"
+	errNotClientObject             = "managed resource is not a client object"
+)
+
+// ClusterScopedConvertible is implemented by namespaced managed resources, that share spec, status and
+// controller implementation with their cluster scoped counterpart.
+type ClusterScopedConvertible interface {
+	resource.Managed
+	// ClusterScoped returns a copy of the resource as its cluster scoped counterpart.
+	ClusterScoped() resource.Managed
+	// SetFromClusterScoped takes over metadata, spec and status of the cluster scoped copy.
+	SetFromClusterScoped(resource.Managed)
+}
+
+// NamespacedSetup adds a controller for a namespaced managed resource, using the external client of its cluster scoped counterpart
+// created by connectorFn. The resource may only use the NamespacedProviderConfig, secrets and references of its own namespace.
+// Dependencies between namespaced resources are not tracked by ResourceUsages, which are cluster scoped.
+func NamespacedSetup(mgr ctrl.Manager, o controller.Options, object client.Object, kind string, gvk schema.GroupVersionKind, connectorFn ConnectorFn) error {
+	name := managed.ControllerName(kind)
+
+	withAPIErrorsConnector, withAPIErrorsReconciler := withAPIErrors(o.PollInterval)
+	r := managed.NewReconciler(
+		mgr,
+		resource.ManagedKind(gvk),
+		managed.WithExternalConnecter(withAPIErrorsConnector(&namespacedConnector{
+			kube:  mgr.GetClient(),
+			inner: connectorFn(mgr.GetClient(), NewUsageTracker(mgr.GetClient()), noOpReferenceTracker{}, btp.NewBTPClient),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(&namespacedConnectionPublisher{
+			publisher: managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
+		}),
+		enableBetaManagementPolicies(o.Features.Enabled(features.EnableBetaManagementPolicies)),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(object).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(ratelimiter.NewReconciler(name, withAPIErrorsReconciler(r), o.GlobalRateLimiter))
+}
+
+// namespacedConnector connects the external client of the cluster scoped counterpart.
+type namespacedConnector struct {
+	kube  client.Client
+	inner managed.ExternalConnecter
+}
+
+func (c *namespacedConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(ClusterScopedConvertible)
+	if !ok {
+		return nil, errors.New(errNotClusterScopedConvertible)
+	}
+	cs := cr.ClusterScoped()
+	ext, err := c.inner.Connect(ctx, cs)
+	cr.SetFromClusterScoped(cs)
+	if err != nil {
+		return nil, err
+	}
+	return &namespacedExternal{kube: c.kube, inner: ext}, nil
+}
+
+// namespacedExternal calls the external client with the cluster scoped counterpart and takes over all changes made by it.
+type namespacedExternal struct {
+	kube  client.Client
+	inner managed.ExternalClient
+}
+
+func (e *namespacedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	var obs managed.ExternalObservation
+	err := e.call(mg, func(cs resource.Managed) (err error) {
+		obs, err = e.inner.Observe(ctx, cs)
+		return err
+	})
+	return obs, err
+}
+
+func (e *namespacedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	var creation managed.ExternalCreation
+	err := e.call(mg, func(cs resource.Managed) (err error) {
+		creation, err = e.inner.Create(ctx, cs)
+		return err
+	})
+	if err != nil {
+		return creation, err
+	}
+	return creation, errors.Wrap(SaveCreationStatus(ctx, e.kube, mg), errSaveCreationStatus)
+}
+
+// SaveCreationStatus persists the status set during creation, like the ID of an asynchronous job, which the reconciler
+// would drop otherwise. A copy is saved to keep the external-name in memory, the resource version is taken over to update
+// it afterwards. External clients save cluster scoped resources themselves, namespaced resources are saved by NamespacedSetup,
+// as their external clients only have the cluster scoped copy.
+func SaveCreationStatus(ctx context.Context, kube client.StatusClient, mg resource.Managed) error {
+	saved, ok := mg.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.New(errNotClientObject)
+	}
+	if err := kube.Status().Update(ctx, saved); err != nil {
+		return err
+	}
+	mg.SetResourceVersion(saved.GetResourceVersion())
+	return nil
+}
+
+func (e *namespacedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	var update managed.ExternalUpdate
+	err := e.call(mg, func(cs resource.Managed) (err error) {
+		update, err = e.inner.Update(ctx, cs)
+		return err
+	})
+	return update, err
+}
+
+func (e *namespacedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	return e.call(mg, func(cs resource.Managed) error {
+		return e.inner.Delete(ctx, cs)
+	})
+}
+
+func (e *namespacedExternal) call(mg resource.Managed, fn func(cs resource.Managed) error) error {
+	cr, ok := mg.(ClusterScopedConvertible)
+	if !ok {
+		return errors.New(errNotClusterScopedConvertible)
+	}
+	cs := cr.ClusterScoped()
+	err := fn(cs)
+	cr.SetFromClusterScoped(cs)
+	return err
+}
+
+// namespacedConnectionPublisher prevents namespaced resources from writing connection secrets into other namespaces.
+type namespacedConnectionPublisher struct {
+	publisher managed.ConnectionPublisher
+}
+
+func (p *namespacedConnectionPublisher) PublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+	if err := connectionSecretInNamespace(so); err != nil {
+		return false, err
+	}
+	return p.publisher.PublishConnection(ctx, so, c)
+}
+
+func (p *namespacedConnectionPublisher) UnpublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) error {
+	if err := connectionSecretInNamespace(so); err != nil {
+		return err
+	}
+	return p.publisher.UnpublishConnection(ctx, so, c)
+}
+
+func connectionSecretInNamespace(so resource.ConnectionSecretOwner) error {
+	ref := so.GetWriteConnectionSecretToReference()
+	if ref != nil && ref.Namespace != so.GetNamespace() {
+		return errors.Errorf(errConnectionSecretNamespace, so.GetNamespace())
+	}
+	return nil
+}
+
+// noOpReferenceTracker is used for namespaced resources, ResourceUsages can not be owned by namespaced resources.
+type noOpReferenceTracker struct{}
+
+func (noOpReferenceTracker) Track(context.Context, resource.Managed) error { return nil }
+
+func (noOpReferenceTracker) SetConditions(context.Context, resource.Managed) {}
+
+func (noOpReferenceTracker) ResolveSource(context.Context, providerv1alpha1.ResourceUsage) (*metav1.PartialObjectMetadata, error) {
+	return nil, nil
+}
+
+func (noOpReferenceTracker) ResolveTarget(context.Context, providerv1alpha1.ResourceUsage) (*metav1.PartialObjectMetadata, error) {
+	return nil, nil
+}
+
+func (noOpReferenceTracker) DeleteShouldBeBlocked(resource.Managed) bool { return false }
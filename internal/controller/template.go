@@ -9,6 +9,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/cloudmanagement"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/directory"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/entitlement"
+	"github.com/sap/crossplane-provider-btp/internal/controller/account/entitlementcatalog"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/globalaccount"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/resourceusage"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/servicemanager"
@@ -28,6 +29,7 @@ import (
 func CustomSetup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		globalaccount.Setup,
+		entitlementcatalog.Setup,
 		subaccount.Setup,
 		cloudfoundry.Setup,
 		kyma.Setup,
@@ -44,6 +46,9 @@ func CustomSetup(mgr ctrl.Manager, o controller.Options) error {
 		serviceinstance.Setup,
 		servicebinding.Setup,
 		kymaenvironmentbinding.Setup,
+		subaccount.SetupNamespaced,
+		directory.SetupNamespaced,
+		entitlement.SetupNamespaced,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
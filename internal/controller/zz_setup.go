@@ -22,8 +22,11 @@ import (
 	"github.com/crossplane/upjet/pkg/controller"
 
 	directoryentitlement "github.com/sap/crossplane-provider-btp/internal/controller/account/directoryentitlement"
+	subaccountentitlement "github.com/sap/crossplane-provider-btp/internal/controller/account/subaccountentitlement"
 	subaccountservicebroker "github.com/sap/crossplane-provider-btp/internal/controller/account/subaccountservicebroker"
 	providerconfig "github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	directoryrolecollection "github.com/sap/crossplane-provider-btp/internal/controller/security/directoryrolecollection"
+	directoryrolecollectionassignment "github.com/sap/crossplane-provider-btp/internal/controller/security/directoryrolecollectionassignment"
 	globalaccounttrustconfiguration "github.com/sap/crossplane-provider-btp/internal/controller/security/globalaccounttrustconfiguration"
 	subaccountapicredential "github.com/sap/crossplane-provider-btp/internal/controller/security/subaccountapicredential"
 	subaccounttrustconfiguration "github.com/sap/crossplane-provider-btp/internal/controller/security/subaccounttrustconfiguration"
@@ -34,8 +37,11 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		directoryentitlement.Setup,
+		subaccountentitlement.Setup,
 		subaccountservicebroker.Setup,
 		providerconfig.Setup,
+		directoryrolecollection.Setup,
+		directoryrolecollectionassignment.Setup,
 		globalaccounttrustconfiguration.Setup,
 		subaccountapicredential.Setup,
 		subaccounttrustconfiguration.Setup,